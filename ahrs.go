@@ -0,0 +1,186 @@
+package glmatrix
+
+import "math"
+
+// The orientation filters below estimate a quat rotating vectors from the
+// sensor frame to the earth frame, whose z axis points up and whose x axis
+// points to the magnetic north. Angular velocities are in radians per second.
+// The accelerometer and the magnetometer readings may be in any unit since
+// only their directions are used, and either of them may be nil to skip
+// the correction they provide.
+
+// Madgwick is an orientation filter by Sebastian Madgwick's gradient descent algorithm
+type Madgwick struct {
+	// Beta is the gain of the gradient descent correction
+	Beta float64
+
+	// Quat is the current estimated orientation
+	Quat []float64
+}
+
+// NewMadgwick creates a new Madgwick filter with the given gain
+func NewMadgwick(beta float64) *Madgwick {
+	return &Madgwick{Beta: beta, Quat: QuatCreate()}
+}
+
+// Step updates the orientation with the sensor readings taken over dt seconds
+// and returns it
+func (f *Madgwick) Step(gyro, accel, mag []float64, dt float64) []float64 {
+	q := f.Quat
+	qdot := QuatMultiply(QuatCreate(), q, []float64{gyro[0], gyro[1], gyro[2], 0})
+	QuatScale(qdot, qdot, 0.5)
+
+	grad := []float64{0, 0, 0, 0}
+	if a := ahrsDirection(accel); a != nil {
+		madgwickGradient(grad, q, []float64{0, 0, 1}, a)
+		if m := ahrsDirection(mag); m != nil {
+			h := Vec3TransformQuat(Vec3Create(), m, q)
+			b := []float64{math.Hypot(h[0], h[1]), 0, h[2]}
+			madgwickGradient(grad, q, b, m)
+		}
+	}
+	if QuatLength(grad) > 0 {
+		QuatNormalize(grad, grad)
+		QuatScaleAndAdd(qdot, qdot, grad, -f.Beta)
+	}
+
+	QuatScaleAndAdd(q, q, qdot, dt)
+	return QuatNormalize(q, q)
+}
+
+// madgwickGradient adds J^T f to grad where f is the difference between the
+// earth frame reference direction d seen from the sensor and the measured direction s
+func madgwickGradient(grad, q, d, s []float64) {
+	conj := QuatConjugate(QuatCreate(), q)
+	pure := []float64{d[0], d[1], d[2], 0}
+	dq := QuatMultiply(QuatCreate(), pure, q)
+	qd := QuatMultiply(QuatCreate(), conj, pure)
+	v := QuatMultiply(QuatCreate(), conj, dq)
+	fx := v[0] - s[0]
+	fy := v[1] - s[1]
+	fz := v[2] - s[2]
+
+	e := QuatCreate()
+	tmp := QuatCreate()
+	dv := QuatCreate()
+	for k := 0; k < 4; k++ {
+		QuatSet(e, 0, 0, 0, 0)
+		e[k] = 1
+		QuatMultiply(dv, QuatConjugate(tmp, e), dq)
+		QuatMultiply(tmp, qd, e)
+		QuatAdd(dv, dv, tmp)
+		grad[k] += dv[0]*fx + dv[1]*fy + dv[2]*fz
+	}
+}
+
+// Mahony is an orientation filter by Robert Mahony's nonlinear complementary filter
+type Mahony struct {
+	// Kp is the proportional gain of the correction
+	Kp float64
+
+	// Ki is the integral gain of the correction, which compensates gyroscope bias
+	Ki float64
+
+	// Quat is the current estimated orientation
+	Quat []float64
+
+	// Bias is the current integral feedback added to the gyroscope readings
+	Bias []float64
+}
+
+// NewMahony creates a new Mahony filter with the given gains
+func NewMahony(kp, ki float64) *Mahony {
+	return &Mahony{Kp: kp, Ki: ki, Quat: QuatCreate(), Bias: Vec3Create()}
+}
+
+// Step updates the orientation with the sensor readings taken over dt seconds
+// and returns it
+func (f *Mahony) Step(gyro, accel, mag []float64, dt float64) []float64 {
+	q := f.Quat
+	conj := QuatConjugate(QuatCreate(), q)
+	e := Vec3Create()
+	tmp := Vec3Create()
+	if a := ahrsDirection(accel); a != nil {
+		v := Vec3TransformQuat(Vec3Create(), []float64{0, 0, 1}, conj)
+		Vec3Add(e, e, Vec3Cross(tmp, a, v))
+		if m := ahrsDirection(mag); m != nil {
+			h := Vec3TransformQuat(Vec3Create(), m, q)
+			w := Vec3TransformQuat(Vec3Create(), []float64{math.Hypot(h[0], h[1]), 0, h[2]}, conj)
+			Vec3Add(e, e, Vec3Cross(tmp, m, w))
+		}
+	}
+
+	if f.Ki > 0 {
+		Vec3ScaleAndAdd(f.Bias, f.Bias, e, f.Ki*dt)
+	} else {
+		Vec3Zero(f.Bias)
+	}
+	g := Vec3Add(Vec3Create(), gyro, f.Bias)
+	Vec3ScaleAndAdd(g, g, e, f.Kp)
+
+	QuatMultiply(q, q, SO3ExpQuat(QuatCreate(), Vec3Scale(g, g, dt)))
+	return QuatNormalize(q, q)
+}
+
+// Complementary is an orientation filter blending the integrated gyroscope
+// readings with the orientation measured by the accelerometer and the magnetometer
+type Complementary struct {
+	// Alpha is the weight of the gyroscope in [0, 1]
+	Alpha float64
+
+	// Quat is the current estimated orientation
+	Quat []float64
+}
+
+// NewComplementary creates a new complementary filter with the given gyroscope weight
+func NewComplementary(alpha float64) *Complementary {
+	return &Complementary{Alpha: alpha, Quat: QuatCreate()}
+}
+
+// Step updates the orientation with the sensor readings taken over dt seconds
+// and returns it
+func (f *Complementary) Step(gyro, accel, mag []float64, dt float64) []float64 {
+	q := f.Quat
+	QuatMultiply(q, q, SO3ExpQuat(QuatCreate(), Vec3Scale(Vec3Create(), gyro, dt)))
+	QuatNormalize(q, q)
+
+	a := ahrsDirection(accel)
+	if a == nil {
+		return q
+	}
+	measured := QuatCreate()
+	m := ahrsDirection(mag)
+	if m != nil {
+		Vec3ScaleAndAdd(m, m, a, -Vec3Dot(m, a))
+	}
+	if m != nil && Vec3Length(m) > Epsilon {
+		Vec3Normalize(m, m)
+		y := Vec3Cross(Vec3Create(), a, m)
+		r := []float64{
+			m[0], y[0], a[0],
+			m[1], y[1], a[1],
+			m[2], y[2], a[2],
+		}
+		QuatNormalize(measured, QuatFromMat3(measured, r))
+	} else {
+		// correct the tilt only and keep the heading of the gyroscope
+		up := Vec3TransformQuat(Vec3Create(), a, q)
+		axis := Vec3Cross(Vec3Create(), up, []float64{0, 0, 1})
+		if Vec3Length(axis) > Epsilon {
+			QuatSetAxisAngle(measured, Vec3Normalize(axis, axis), Vec3Angle(up, []float64{0, 0, 1}))
+		} else {
+			QuatRotationTo(measured, up, []float64{0, 0, 1})
+		}
+		QuatMultiply(measured, measured, q)
+	}
+	QuatSlerp(q, q, measured, 1-f.Alpha)
+	return QuatNormalize(q, q)
+}
+
+// ahrsDirection returns the normalized copy of a reading or nil if it is unavailable
+func ahrsDirection(v []float64) []float64 {
+	if v == nil || Vec3Length(v) == 0 {
+		return nil
+	}
+	return Vec3Normalize(Vec3Create(), v)
+}
@@ -0,0 +1,126 @@
+package glmatrix
+
+import (
+	"testing"
+)
+
+// ahrsReadings returns the accelerometer and magnetometer readings
+// of a sensor at rest with the given orientation
+func ahrsReadings(q []float64) ([]float64, []float64) {
+	conj := QuatConjugate(QuatCreate(), q)
+	accel := Vec3TransformQuat(Vec3Create(), []float64{0, 0, 9.8}, conj)
+	mag := Vec3TransformQuat(Vec3Create(), []float64{20, 0, -40}, conj)
+	return accel, mag
+}
+
+var ahrsTruth = QuatNormalize(QuatCreate(), QuatFromEuler(QuatCreate(), 30, -20, 100))
+
+type ahrsFilter interface {
+	Step(gyro, accel, mag []float64, dt float64) []float64
+}
+
+func TestAHRSGyroIntegration(t *testing.T) {
+	filters := map[string]ahrsFilter{
+		"madgwick":      NewMadgwick(0.1),
+		"mahony":        NewMahony(1, 0),
+		"complementary": NewComplementary(0.98),
+	}
+	expect := QuatSetAxisAngle(QuatCreate(), []float64{0, 0, 1}, 1)
+	for name, f := range filters {
+		var actual []float64
+		for i := 0; i < 1000; i++ {
+			actual = f.Step([]float64{0, 0, 1}, nil, nil, 0.001)
+		}
+		if !testSliceTol(actual, expect, 1e-6) {
+			t.Errorf("%v gyro integration: %v", name, actual)
+		}
+	}
+}
+
+func TestMadgwickConvergence(t *testing.T) {
+	f := NewMadgwick(0.5)
+	accel, mag := ahrsReadings(ahrsTruth)
+	for i := 0; i < 2000; i++ {
+		f.Step([]float64{0, 0, 0}, accel, mag, 0.01)
+	}
+	// a smaller gain reduces the steady state ripple
+	f.Beta = 0.01
+	var actual []float64
+	for i := 0; i < 1000; i++ {
+		actual = f.Step([]float64{0, 0, 0}, accel, mag, 0.01)
+	}
+	if d := QuatAngularDistance(actual, ahrsTruth); d > 1e-3 {
+		t.Errorf("madgwick convergence: %v %v", actual, d)
+	}
+
+	f = NewMadgwick(0.5)
+	for i := 0; i < 2000; i++ {
+		f.Step([]float64{0, 0, 0}, accel, nil, 0.01)
+	}
+	f.Beta = 0.01
+	for i := 0; i < 1000; i++ {
+		actual = f.Step([]float64{0, 0, 0}, accel, nil, 0.01)
+	}
+	up := Vec3TransformQuat(Vec3Create(), accel, actual)
+	if angle := Vec3Angle(up, []float64{0, 0, 1}); angle > 1e-3 {
+		t.Errorf("madgwick tilt: %v", angle)
+	}
+}
+
+func TestMahonyConvergence(t *testing.T) {
+	f := NewMahony(2, 0)
+	accel, mag := ahrsReadings(ahrsTruth)
+	var actual []float64
+	for i := 0; i < 10000; i++ {
+		actual = f.Step([]float64{0, 0, 0}, accel, mag, 0.01)
+	}
+	if d := QuatAngularDistance(actual, ahrsTruth); d > 1e-6 {
+		t.Errorf("mahony convergence: %v %v", actual, d)
+	}
+
+	f = NewMahony(2, 0)
+	for i := 0; i < 5000; i++ {
+		actual = f.Step([]float64{0, 0, 0}, accel, nil, 0.01)
+	}
+	up := Vec3TransformQuat(Vec3Create(), accel, actual)
+	if angle := Vec3Angle(up, []float64{0, 0, 1}); angle > 1e-6 {
+		t.Errorf("mahony tilt: %v", angle)
+	}
+}
+
+func TestComplementaryConvergence(t *testing.T) {
+	f := NewComplementary(0.95)
+	accel, mag := ahrsReadings(ahrsTruth)
+	var actual []float64
+	for i := 0; i < 1000; i++ {
+		actual = f.Step([]float64{0, 0, 0}, accel, mag, 0.01)
+	}
+	if d := QuatAngularDistance(actual, ahrsTruth); d > 1e-6 {
+		t.Errorf("complementary convergence: %v %v", actual, d)
+	}
+
+	f = NewComplementary(0.95)
+	for i := 0; i < 1000; i++ {
+		actual = f.Step([]float64{0, 0, 0}, accel, nil, 0.01)
+	}
+	up := Vec3TransformQuat(Vec3Create(), accel, actual)
+	if angle := Vec3Angle(up, []float64{0, 0, 1}); angle > 1e-6 {
+		t.Errorf("complementary tilt: %v", angle)
+	}
+}
+
+func TestMahonyGyroBias(t *testing.T) {
+	f := NewMahony(2, 0.5)
+	accel, mag := ahrsReadings(ahrsTruth)
+	bias := []float64{0.02, -0.01, 0.03}
+	var actual []float64
+	for i := 0; i < 10000; i++ {
+		actual = f.Step(bias, accel, mag, 0.01)
+	}
+	if d := QuatAngularDistance(actual, ahrsTruth); d > 1e-3 {
+		t.Errorf("mahony bias convergence: %v %v", actual, d)
+	}
+	if !testSliceTol(f.Bias, Vec3Negate(Vec3Create(), bias), 1e-4) {
+		t.Errorf("mahony bias: %v", f.Bias)
+	}
+}
@@ -0,0 +1,321 @@
+package glmatrix
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// BinaryFormat is an encoding of float values. The zero value encodes
+// little-endian float64 values, which is also the encoding of MarshalBinary.
+type BinaryFormat struct {
+	// Order is the byte order. Defaults to binary.LittleEndian.
+	Order binary.ByteOrder
+
+	// Float32 encodes the values as float32 instead of float64
+	Float32 bool
+}
+
+func (f BinaryFormat) order() binary.ByteOrder {
+	if f.Order == nil {
+		return binary.LittleEndian
+	}
+	return f.Order
+}
+
+// Size returns the number of bytes of n encoded values
+func (f BinaryFormat) Size(n int) int {
+	if f.Float32 {
+		return n * 4
+	}
+	return n * 8
+}
+
+// Append appends the encoded values of a to dst and returns the extended slice
+func (f BinaryFormat) Append(dst []byte, a []float64) []byte {
+	order := f.order()
+	start := len(dst)
+	dst = append(dst, make([]byte, f.Size(len(a)))...)
+	for i, v := range a {
+		if f.Float32 {
+			order.PutUint32(dst[start+i*4:], math.Float32bits(float32(v)))
+		} else {
+			order.PutUint64(dst[start+i*8:], math.Float64bits(v))
+		}
+	}
+	return dst
+}
+
+// Decode decodes len(out) values from data, which must hold exactly that many values
+func (f BinaryFormat) Decode(out []float64, data []byte) error {
+	if len(data) != f.Size(len(out)) {
+		return fmt.Errorf("glmatrix: expected %v bytes for %v values but got %v", f.Size(len(out)), len(out), len(data))
+	}
+	f.decode(out, data)
+	return nil
+}
+
+func (f BinaryFormat) decode(out []float64, data []byte) {
+	order := f.order()
+	for i := range out {
+		if f.Float32 {
+			out[i] = float64(math.Float32frombits(order.Uint32(data[i*4:])))
+		} else {
+			out[i] = math.Float64frombits(order.Uint64(data[i*8:]))
+		}
+	}
+}
+
+// binaryChunk is the number of values encoded or decoded at once by the streams
+const binaryChunk = 1024
+
+// BinaryEncoder writes encoded values to an output stream
+type BinaryEncoder struct {
+	w      io.Writer
+	format BinaryFormat
+	buf    []byte
+}
+
+// NewBinaryEncoder creates a new encoder writing to w in the given format
+func NewBinaryEncoder(w io.Writer, format BinaryFormat) *BinaryEncoder {
+	return &BinaryEncoder{w: w, format: format}
+}
+
+// Encode writes the values of a, e.g. a whole vertex array, without any header
+func (e *BinaryEncoder) Encode(a []float64) error {
+	for len(a) > 0 {
+		n := len(a)
+		if n > binaryChunk {
+			n = binaryChunk
+		}
+		e.buf = e.format.Append(e.buf[:0], a[:n])
+		if _, err := e.w.Write(e.buf); err != nil {
+			return err
+		}
+		a = a[n:]
+	}
+	return nil
+}
+
+// EncodeArray writes the number of values of a as a uint32 in the byte order
+// of the format followed by the values
+func (e *BinaryEncoder) EncodeArray(a []float64) error {
+	if uint64(len(a)) > math.MaxUint32 {
+		return fmt.Errorf("glmatrix: array of %v values is too long to encode", len(a))
+	}
+	var header [4]byte
+	e.format.order().PutUint32(header[:], uint32(len(a)))
+	if _, err := e.w.Write(header[:]); err != nil {
+		return err
+	}
+	return e.Encode(a)
+}
+
+// BinaryDecoder reads encoded values from an input stream
+type BinaryDecoder struct {
+	r      io.Reader
+	format BinaryFormat
+	buf    []byte
+}
+
+// NewBinaryDecoder creates a new decoder reading from r in the given format
+func NewBinaryDecoder(r io.Reader, format BinaryFormat) *BinaryDecoder {
+	return &BinaryDecoder{r: r, format: format}
+}
+
+// Decode reads len(out) values into out. It returns io.EOF if the stream ends
+// before the first value and an error wrapping io.ErrUnexpectedEOF if it ends
+// in the middle of the values.
+func (d *BinaryDecoder) Decode(out []float64) error {
+	read := 0
+	for read < len(out) {
+		n := len(out) - read
+		if n > binaryChunk {
+			n = binaryChunk
+		}
+		size := d.format.Size(n)
+		if cap(d.buf) < size {
+			d.buf = make([]byte, size)
+		}
+		got, err := io.ReadFull(d.r, d.buf[:size])
+		if err != nil {
+			if read == 0 && err == io.EOF {
+				return io.EOF
+			}
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				total := read*d.format.Size(1) + got
+				return fmt.Errorf("glmatrix: truncated input, expected %v bytes for %v values but got %v: %w",
+					d.format.Size(len(out)), len(out), total, io.ErrUnexpectedEOF)
+			}
+			return err
+		}
+		d.format.decode(out[read:read+n], d.buf[:size])
+		read += n
+	}
+	return nil
+}
+
+// DecodeArray reads an array written by BinaryEncoder.EncodeArray. It returns
+// an error if the array has more than max values, to guard against corrupted
+// lengths, and io.EOF if the stream ends before the length.
+func (d *BinaryDecoder) DecodeArray(max int) ([]float64, error) {
+	var header [4]byte
+	if _, err := io.ReadFull(d.r, header[:]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return nil, fmt.Errorf("glmatrix: truncated input, incomplete array length: %w", err)
+		}
+		return nil, err
+	}
+	n := d.format.order().Uint32(header[:])
+	if uint64(n) > uint64(max) {
+		return nil, fmt.Errorf("glmatrix: array of %v values exceeds the limit of %v", n, max)
+	}
+	out := make([]float64, n)
+	if err := d.Decode(out); err != nil {
+		if err == io.EOF {
+			return nil, fmt.Errorf("glmatrix: truncated input, missing %v values of an array: %w", n, io.ErrUnexpectedEOF)
+		}
+		return nil, err
+	}
+	return out, nil
+}
+
+func marshalBinary(a []float64, n int, name string) ([]byte, error) {
+	if len(a) != n {
+		return nil, fmt.Errorf("glmatrix: %v must have %v values but has %v", name, n, len(a))
+	}
+	return BinaryFormat{}.Append(make([]byte, 0, n*8), a), nil
+}
+
+func unmarshalBinary(a *[]float64, data []byte, n int, name string) error {
+	if len(data) != n*8 {
+		return fmt.Errorf("glmatrix: expected %v bytes for a %v but got %v", n*8, name, len(data))
+	}
+	if len(*a) != n {
+		*a = make([]float64, n)
+	}
+	BinaryFormat{}.decode(*a, data)
+	return nil
+}
+
+// MarshalBinary encodes a vec2 as little-endian float64 values
+func (a Vec2) MarshalBinary() ([]byte, error) {
+	return marshalBinary(a, 2, "vec2")
+}
+
+// UnmarshalBinary decodes a vec2 encoded by MarshalBinary
+func (a *Vec2) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary((*[]float64)(a), data, 2, "vec2")
+}
+
+// MarshalBinary encodes a Vec3 as little-endian float64 values
+func (a Vec3) MarshalBinary() ([]byte, error) {
+	return marshalBinary(a, 3, "vec3")
+}
+
+// UnmarshalBinary decodes a Vec3 encoded by MarshalBinary
+func (a *Vec3) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary((*[]float64)(a), data, 3, "vec3")
+}
+
+// MarshalBinary encodes a vec4 as little-endian float64 values
+func (a Vec4) MarshalBinary() ([]byte, error) {
+	return marshalBinary(a, 4, "vec4")
+}
+
+// UnmarshalBinary decodes a vec4 encoded by MarshalBinary
+func (a *Vec4) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary((*[]float64)(a), data, 4, "vec4")
+}
+
+// MarshalBinary encodes a mat2 as little-endian float64 values
+func (a Mat2) MarshalBinary() ([]byte, error) {
+	return marshalBinary(a, 4, "mat2")
+}
+
+// UnmarshalBinary decodes a mat2 encoded by MarshalBinary
+func (a *Mat2) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary((*[]float64)(a), data, 4, "mat2")
+}
+
+// MarshalBinary encodes a mat2d as little-endian float64 values
+func (a Mat2d) MarshalBinary() ([]byte, error) {
+	return marshalBinary(a, 6, "mat2d")
+}
+
+// UnmarshalBinary decodes a mat2d encoded by MarshalBinary
+func (a *Mat2d) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary((*[]float64)(a), data, 6, "mat2d")
+}
+
+// MarshalBinary encodes a mat3 as little-endian float64 values
+func (a Mat3) MarshalBinary() ([]byte, error) {
+	return marshalBinary(a, 9, "mat3")
+}
+
+// UnmarshalBinary decodes a mat3 encoded by MarshalBinary
+func (a *Mat3) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary((*[]float64)(a), data, 9, "mat3")
+}
+
+// MarshalBinary encodes a mat4 as little-endian float64 values
+func (a Mat4) MarshalBinary() ([]byte, error) {
+	return marshalBinary(a, 16, "mat4")
+}
+
+// UnmarshalBinary decodes a mat4 encoded by MarshalBinary
+func (a *Mat4) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary((*[]float64)(a), data, 16, "mat4")
+}
+
+// MarshalBinary encodes a quat as little-endian float64 values
+func (a Quat) MarshalBinary() ([]byte, error) {
+	return marshalBinary(a, 4, "quat")
+}
+
+// UnmarshalBinary decodes a quat encoded by MarshalBinary
+func (a *Quat) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary((*[]float64)(a), data, 4, "quat")
+}
+
+// MarshalBinary encodes a quat2 as little-endian float64 values
+func (a Quat2) MarshalBinary() ([]byte, error) {
+	return marshalBinary(a, 8, "quat2")
+}
+
+// UnmarshalBinary decodes a quat2 encoded by MarshalBinary
+func (a *Quat2) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary((*[]float64)(a), data, 8, "quat2")
+}
+
+// MarshalBinary encodes a mat2x3 as little-endian float64 values
+func (a Mat2x3) MarshalBinary() ([]byte, error) {
+	return marshalBinary(a, 6, "mat2x3")
+}
+
+// UnmarshalBinary decodes a mat2x3 encoded by MarshalBinary
+func (a *Mat2x3) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary((*[]float64)(a), data, 6, "mat2x3")
+}
+
+// MarshalBinary encodes a mat3x4 as little-endian float64 values
+func (a Mat3x4) MarshalBinary() ([]byte, error) {
+	return marshalBinary(a, 12, "mat3x4")
+}
+
+// UnmarshalBinary decodes a mat3x4 encoded by MarshalBinary
+func (a *Mat3x4) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary((*[]float64)(a), data, 12, "mat3x4")
+}
+
+// MarshalBinary encodes a mat4x3 as little-endian float64 values
+func (a Mat4x3) MarshalBinary() ([]byte, error) {
+	return marshalBinary(a, 12, "mat4x3")
+}
+
+// UnmarshalBinary decodes a mat4x3 encoded by MarshalBinary
+func (a *Mat4x3) UnmarshalBinary(data []byte) error {
+	return unmarshalBinary((*[]float64)(a), data, 12, "mat4x3")
+}
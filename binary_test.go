@@ -0,0 +1,119 @@
+package glmatrix
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+)
+
+var _ encoding.BinaryMarshaler = Vec3{}
+var _ encoding.BinaryUnmarshaler = (*Mat4)(nil)
+
+func TestBinaryFormatAppend(t *testing.T) {
+	actual := BinaryFormat{}.Append(nil, []float64{1})
+	if !bytes.Equal(actual, []byte{0, 0, 0, 0, 0, 0, 0xf0, 0x3f}) {
+		t.Errorf("float64 little endian: %x", actual)
+	}
+	actual = BinaryFormat{Order: binary.BigEndian, Float32: true}.Append(nil, []float64{1, -2})
+	if !bytes.Equal(actual, []byte{0x3f, 0x80, 0, 0, 0xc0, 0, 0, 0}) {
+		t.Errorf("float32 big endian: %x", actual)
+	}
+}
+
+func TestBinaryFormatDecode(t *testing.T) {
+	for _, f := range []BinaryFormat{{}, {Float32: true}, {Order: binary.BigEndian}, {Order: binary.BigEndian, Float32: true}} {
+		data := f.Append(nil, vec4A)
+		if len(data) != f.Size(4) {
+			t.Errorf("%+v size: %v", f, len(data))
+		}
+		actual := Vec4Create()
+		if err := f.Decode(actual, data); err != nil || !testSlice(actual, vec4A) {
+			t.Errorf("%+v decode: %v %v", f, actual, err)
+		}
+		if err := f.Decode(actual, data[1:]); err == nil {
+			t.Errorf("%+v decode short: no error", f)
+		}
+	}
+}
+
+func TestMarshalBinary(t *testing.T) {
+	data, err := Mat4(mat4Identity()).MarshalBinary()
+	if err != nil || len(data) != 128 {
+		t.Errorf("marshal: %v %v", len(data), err)
+	}
+	var m Mat4
+	if err := m.UnmarshalBinary(data); err != nil || !testSlice(m, mat4Identity()) {
+		t.Errorf("unmarshal: %v %v", m, err)
+	}
+	if _, err := Vec3(vec4A).MarshalBinary(); err == nil || err.Error() != "glmatrix: vec3 must have 3 values but has 4" {
+		t.Errorf("marshal wrong length: %v", err)
+	}
+	q := Quat(QuatCreate())
+	if err := q.UnmarshalBinary(data); err == nil {
+		t.Errorf("unmarshal wrong length: no error")
+	}
+}
+
+func mat4Identity() []float64 {
+	return Mat4Identity(Mat4Create())
+}
+
+func TestBinaryEncoderDecoder(t *testing.T) {
+	vertices := make([]float64, 3000)
+	for i := range vertices {
+		vertices[i] = float64(i) / 4
+	}
+	for _, f := range []BinaryFormat{{}, {Order: binary.BigEndian, Float32: true}} {
+		var buf bytes.Buffer
+		e := NewBinaryEncoder(&buf, f)
+		if err := e.Encode(vec3A); err != nil {
+			t.Fatal(err)
+		}
+		if err := e.EncodeArray(vertices); err != nil {
+			t.Fatal(err)
+		}
+		if buf.Len() != f.Size(3)+4+f.Size(3000) {
+			t.Errorf("%+v encoded size: %v", f, buf.Len())
+		}
+
+		d := NewBinaryDecoder(&buf, f)
+		v := Vec3Create()
+		if err := d.Decode(v); err != nil || !testSlice(v, vec3A) {
+			t.Errorf("%+v decode: %v %v", f, v, err)
+		}
+		actual, err := d.DecodeArray(len(vertices))
+		if err != nil || !testSlice(actual, vertices) {
+			t.Errorf("%+v decode array: %v", f, err)
+		}
+		if err := d.Decode(v); err != io.EOF {
+			t.Errorf("%+v decode at end: %v", f, err)
+		}
+	}
+}
+
+func TestBinaryDecoderTruncated(t *testing.T) {
+	data := BinaryFormat{}.Append(nil, []float64{1, 2, 3})
+	d := NewBinaryDecoder(bytes.NewReader(data[:20]), BinaryFormat{})
+	err := d.Decode(Vec3Create())
+	if !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("truncated: %v", err)
+	}
+
+	var buf bytes.Buffer
+	NewBinaryEncoder(&buf, BinaryFormat{}).EncodeArray([]float64{1, 2, 3})
+	d = NewBinaryDecoder(bytes.NewReader(buf.Bytes()), BinaryFormat{})
+	if _, err := d.DecodeArray(2); err == nil {
+		t.Errorf("array over limit: no error")
+	}
+	d = NewBinaryDecoder(bytes.NewReader(buf.Bytes()[:4]), BinaryFormat{})
+	if _, err := d.DecodeArray(3); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("array without values: %v", err)
+	}
+	d = NewBinaryDecoder(bytes.NewReader(buf.Bytes()[:2]), BinaryFormat{})
+	if _, err := d.DecodeArray(3); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("array with truncated length: %v", err)
+	}
+}
@@ -0,0 +1,381 @@
+package glmatrix
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+// BufferLayout is a memory layout of GLSL interface blocks
+type BufferLayout string
+
+const (
+	// Std140 is the layout of uniform blocks
+	Std140 BufferLayout = "std140"
+
+	// Std430 is the layout of shader storage blocks
+	Std430 BufferLayout = "std430"
+)
+
+// vectorAlign returns the base alignment in bytes of a vector with n components
+func vectorAlign(n int) int {
+	switch n {
+	case 1:
+		return 4
+	case 2:
+		return 8
+	}
+	return 16
+}
+
+// arrayStride returns the stride in bytes of the elements of an array of
+// vectors with n components, which also applies to the columns of a matrix
+func arrayStride(layout BufferLayout, n int) int {
+	switch layout {
+	case Std140:
+		return 16
+	case Std430:
+		return vectorAlign(n)
+	}
+	panic(fmt.Sprintf("Unknown buffer layout %v", layout))
+}
+
+// structAlign rounds up the largest alignment of the members of a struct
+func structAlign(layout BufferLayout, align int) int {
+	if layout == Std140 && align < 16 {
+		return 16
+	}
+	return align
+}
+
+func alignOffset(offset, align int) int {
+	return (offset + align - 1) / align * align
+}
+
+// bufferFrame is the state of the outermost block or of a nested struct
+type bufferFrame struct {
+	offset int
+	align  int
+	buf    []byte
+	reads  []func(base int)
+}
+
+// BufferPacker serializes vectors and matrices into a byte slice following
+// the std140 or std430 rules, converting the values to little-endian float32.
+// Members are appended one after another in declaration order.
+type BufferPacker struct {
+	Layout BufferLayout
+	frames []*bufferFrame
+}
+
+// NewBufferPacker creates a new packer with the given layout
+func NewBufferPacker(layout BufferLayout) *BufferPacker {
+	arrayStride(layout, 1)
+	return &BufferPacker{Layout: layout, frames: []*bufferFrame{{align: 4}}}
+}
+
+func (p *BufferPacker) frame() *bufferFrame {
+	return p.frames[len(p.frames)-1]
+}
+
+// vectors appends count vectors of n components. Arrays and matrix columns
+// are padded to the array stride while a single vector is not padded.
+func (p *BufferPacker) vectors(v []float64, n, count int, array bool) {
+	f := p.frame()
+	align := vectorAlign(n)
+	stride := n * 4
+	if array {
+		stride = arrayStride(p.Layout, n)
+		align = stride
+	}
+	if align > f.align {
+		f.align = align
+	}
+	f.offset = alignOffset(f.offset, align)
+	for i := 0; i < count; i++ {
+		f.buf = append(f.buf, make([]byte, f.offset+stride-len(f.buf))...)
+		for j := 0; j < n; j++ {
+			binary.LittleEndian.PutUint32(f.buf[f.offset+j*4:], math.Float32bits(float32(v[i*n+j])))
+		}
+		f.offset += stride
+	}
+}
+
+// Float appends a float
+func (p *BufferPacker) Float(v float64) {
+	p.vectors([]float64{v}, 1, 1, false)
+}
+
+// Vec2 appends a vec2
+func (p *BufferPacker) Vec2(v []float64) {
+	p.vectors(v, 2, 1, false)
+}
+
+// Vec3 appends a Vec3
+func (p *BufferPacker) Vec3(v []float64) {
+	p.vectors(v, 3, 1, false)
+}
+
+// Vec4 appends a vec4
+func (p *BufferPacker) Vec4(v []float64) {
+	p.vectors(v, 4, 1, false)
+}
+
+// Mat2 appends a mat2
+func (p *BufferPacker) Mat2(m []float64) {
+	p.vectors(m, 2, 2, true)
+}
+
+// Mat3 appends a mat3
+func (p *BufferPacker) Mat3(m []float64) {
+	p.vectors(m, 3, 3, true)
+}
+
+// Mat4 appends a mat4
+func (p *BufferPacker) Mat4(m []float64) {
+	p.vectors(m, 4, 4, true)
+}
+
+// FloatArray appends an array of floats
+func (p *BufferPacker) FloatArray(v []float64) {
+	p.vectors(v, 1, len(v), true)
+}
+
+// Vec2Array appends an array of vec2s given one after another
+func (p *BufferPacker) Vec2Array(v []float64) {
+	p.vectors(v, 2, len(v)/2, true)
+}
+
+// Vec3Array appends an array of Vec3s given one after another
+func (p *BufferPacker) Vec3Array(v []float64) {
+	p.vectors(v, 3, len(v)/3, true)
+}
+
+// Vec4Array appends an array of vec4s given one after another
+func (p *BufferPacker) Vec4Array(v []float64) {
+	p.vectors(v, 4, len(v)/4, true)
+}
+
+// Mat2Array appends an array of mat2s given one after another
+func (p *BufferPacker) Mat2Array(m []float64) {
+	p.vectors(m, 2, len(m)/2, true)
+}
+
+// Mat3Array appends an array of mat3s given one after another
+func (p *BufferPacker) Mat3Array(m []float64) {
+	p.vectors(m, 3, len(m)/3, true)
+}
+
+// Mat4Array appends an array of mat4s given one after another
+func (p *BufferPacker) Mat4Array(m []float64) {
+	p.vectors(m, 4, len(m)/4, true)
+}
+
+// BeginStruct starts a struct member. The members appended until the matching
+// EndStruct belong to the struct. An array of structs is packed by a pair of
+// BeginStruct and EndStruct for each element.
+func (p *BufferPacker) BeginStruct() {
+	p.frames = append(p.frames, &bufferFrame{align: 4})
+}
+
+// EndStruct ends the current struct member
+func (p *BufferPacker) EndStruct() {
+	if len(p.frames) == 1 {
+		panic("EndStruct without BeginStruct")
+	}
+	s := p.frame()
+	p.frames = p.frames[:len(p.frames)-1]
+	align := structAlign(p.Layout, s.align)
+	size := alignOffset(s.offset, align)
+
+	f := p.frame()
+	if align > f.align {
+		f.align = align
+	}
+	f.offset = alignOffset(f.offset, align)
+	f.buf = append(f.buf, make([]byte, f.offset-len(f.buf))...)
+	f.buf = append(f.buf, s.buf...)
+	f.buf = append(f.buf, make([]byte, size-len(s.buf))...)
+	f.offset += size
+}
+
+// Bytes returns the packed bytes
+func (p *BufferPacker) Bytes() []byte {
+	if len(p.frames) != 1 {
+		panic("Struct is not ended")
+	}
+	f := p.frame()
+	return append(f.buf, make([]byte, f.offset-len(f.buf))...)
+}
+
+// BufferUnpacker deserializes vectors and matrices packed by BufferPacker.
+// Members must be read in the same order as they were packed. The values of
+// the members of a struct are available after the matching EndStruct.
+type BufferUnpacker struct {
+	Layout BufferLayout
+	data   []byte
+	frames []*bufferFrame
+}
+
+// NewBufferUnpacker creates a new unpacker reading the given bytes with the given layout
+func NewBufferUnpacker(layout BufferLayout, data []byte) *BufferUnpacker {
+	arrayStride(layout, 1)
+	return &BufferUnpacker{Layout: layout, data: data, frames: []*bufferFrame{{align: 4}}}
+}
+
+func (u *BufferUnpacker) frame() *bufferFrame {
+	return u.frames[len(u.frames)-1]
+}
+
+func (u *BufferUnpacker) read(read func(base int)) {
+	if len(u.frames) == 1 {
+		read(0)
+		return
+	}
+	f := u.frame()
+	f.reads = append(f.reads, read)
+}
+
+func (u *BufferUnpacker) vectors(out []float64, n, count int, array bool) {
+	f := u.frame()
+	align := vectorAlign(n)
+	stride := n * 4
+	if array {
+		stride = arrayStride(u.Layout, n)
+		align = stride
+	}
+	if align > f.align {
+		f.align = align
+	}
+	f.offset = alignOffset(f.offset, align)
+	start := f.offset
+	f.offset += stride * count
+	u.read(func(base int) {
+		for i := 0; i < count; i++ {
+			offset := base + start + i*stride
+			if offset+n*4 > len(u.data) {
+				panic(fmt.Sprintf("Buffer of %v bytes is too short to read %v bytes at %v", len(u.data), n*4, offset))
+			}
+			for j := 0; j < n; j++ {
+				out[i*n+j] = float64(math.Float32frombits(binary.LittleEndian.Uint32(u.data[offset+j*4:])))
+			}
+		}
+	})
+}
+
+// Float reads a float
+func (u *BufferUnpacker) Float(out *float64) {
+	v := []float64{0}
+	u.vectors(v, 1, 1, false)
+	u.read(func(int) {
+		*out = v[0]
+	})
+}
+
+// Vec2 reads a vec2
+func (u *BufferUnpacker) Vec2(out []float64) []float64 {
+	u.vectors(out, 2, 1, false)
+	return out
+}
+
+// Vec3 reads a Vec3
+func (u *BufferUnpacker) Vec3(out []float64) []float64 {
+	u.vectors(out, 3, 1, false)
+	return out
+}
+
+// Vec4 reads a vec4
+func (u *BufferUnpacker) Vec4(out []float64) []float64 {
+	u.vectors(out, 4, 1, false)
+	return out
+}
+
+// Mat2 reads a mat2
+func (u *BufferUnpacker) Mat2(out []float64) []float64 {
+	u.vectors(out, 2, 2, true)
+	return out
+}
+
+// Mat3 reads a mat3
+func (u *BufferUnpacker) Mat3(out []float64) []float64 {
+	u.vectors(out, 3, 3, true)
+	return out
+}
+
+// Mat4 reads a mat4
+func (u *BufferUnpacker) Mat4(out []float64) []float64 {
+	u.vectors(out, 4, 4, true)
+	return out
+}
+
+// FloatArray reads an array of len(out) floats
+func (u *BufferUnpacker) FloatArray(out []float64) []float64 {
+	u.vectors(out, 1, len(out), true)
+	return out
+}
+
+// Vec2Array reads an array of len(out) / 2 vec2s
+func (u *BufferUnpacker) Vec2Array(out []float64) []float64 {
+	u.vectors(out, 2, len(out)/2, true)
+	return out
+}
+
+// Vec3Array reads an array of len(out) / 3 Vec3s
+func (u *BufferUnpacker) Vec3Array(out []float64) []float64 {
+	u.vectors(out, 3, len(out)/3, true)
+	return out
+}
+
+// Vec4Array reads an array of len(out) / 4 vec4s
+func (u *BufferUnpacker) Vec4Array(out []float64) []float64 {
+	u.vectors(out, 4, len(out)/4, true)
+	return out
+}
+
+// Mat2Array reads an array of len(out) / 4 mat2s
+func (u *BufferUnpacker) Mat2Array(out []float64) []float64 {
+	u.vectors(out, 2, len(out)/2, true)
+	return out
+}
+
+// Mat3Array reads an array of len(out) / 9 mat3s
+func (u *BufferUnpacker) Mat3Array(out []float64) []float64 {
+	u.vectors(out, 3, len(out)/3, true)
+	return out
+}
+
+// Mat4Array reads an array of len(out) / 16 mat4s
+func (u *BufferUnpacker) Mat4Array(out []float64) []float64 {
+	u.vectors(out, 4, len(out)/4, true)
+	return out
+}
+
+// BeginStruct starts a struct member
+func (u *BufferUnpacker) BeginStruct() {
+	u.frames = append(u.frames, &bufferFrame{align: 4})
+}
+
+// EndStruct ends the current struct member. The offset of a struct depends on
+// the alignment of all of its members, so the members are read here.
+func (u *BufferUnpacker) EndStruct() {
+	if len(u.frames) == 1 {
+		panic("EndStruct without BeginStruct")
+	}
+	s := u.frame()
+	u.frames = u.frames[:len(u.frames)-1]
+	align := structAlign(u.Layout, s.align)
+	size := alignOffset(s.offset, align)
+
+	f := u.frame()
+	if align > f.align {
+		f.align = align
+	}
+	f.offset = alignOffset(f.offset, align)
+	start := f.offset
+	f.offset += size
+	u.read(func(base int) {
+		for _, read := range s.reads {
+			read(base + start)
+		}
+	})
+}
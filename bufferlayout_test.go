@@ -0,0 +1,148 @@
+package glmatrix
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+)
+
+func testBufferFloat(b []byte, offset int) float64 {
+	return float64(math.Float32frombits(binary.LittleEndian.Uint32(b[offset:])))
+}
+
+func testBufferOffsets(t *testing.T, name string, b []byte, size int, offsets map[int]float64) {
+	if len(b) != size {
+		t.Errorf("%v: size %v", name, len(b))
+	}
+	for offset, expect := range offsets {
+		if actual := testBufferFloat(b, offset); actual != expect {
+			t.Errorf("%v: %v at %v", name, actual, offset)
+		}
+	}
+}
+
+func testBufferBlock(layout BufferLayout) []byte {
+	p := NewBufferPacker(layout)
+	p.Float(1)
+	p.Vec3([]float64{2, 3, 4})
+	p.Float(5)
+	p.Vec2([]float64{6, 7})
+	p.Mat3([]float64{8, 9, 10, 11, 12, 13, 14, 15, 16})
+	p.FloatArray([]float64{17, 18})
+	return p.Bytes()
+}
+
+func TestBufferPackerStd140(t *testing.T) {
+	b := testBufferBlock(Std140)
+	testBufferOffsets(t, "std140", b, 128, map[int]float64{
+		0: 1, 16: 2, 24: 4, 28: 5, 32: 6, 36: 7,
+		48: 8, 56: 10, 64: 11, 80: 14, 88: 16,
+		96: 17, 112: 18,
+	})
+}
+
+func TestBufferPackerStd430(t *testing.T) {
+	b := testBufferBlock(Std430)
+	testBufferOffsets(t, "std430", b, 104, map[int]float64{
+		0: 1, 16: 2, 24: 4, 28: 5, 32: 6, 36: 7,
+		48: 8, 56: 10, 64: 11, 80: 14, 88: 16,
+		96: 17, 100: 18,
+	})
+}
+
+func TestBufferPackerArrays(t *testing.T) {
+	p := NewBufferPacker(Std140)
+	p.Vec2Array([]float64{1, 2, 3, 4})
+	p.Mat2([]float64{5, 6, 7, 8})
+	testBufferOffsets(t, "std140 arrays", p.Bytes(), 64, map[int]float64{
+		0: 1, 4: 2, 16: 3, 20: 4, 32: 5, 36: 6, 48: 7, 52: 8,
+	})
+
+	p = NewBufferPacker(Std430)
+	p.Vec2Array([]float64{1, 2, 3, 4})
+	p.Mat2([]float64{5, 6, 7, 8})
+	p.Vec3Array([]float64{9, 10, 11, 12, 13, 14})
+	testBufferOffsets(t, "std430 arrays", p.Bytes(), 64, map[int]float64{
+		0: 1, 4: 2, 8: 3, 12: 4, 16: 5, 20: 6, 24: 7, 28: 8,
+		32: 9, 40: 11, 48: 12, 56: 14,
+	})
+}
+
+func testBufferStruct(layout BufferLayout) []byte {
+	p := NewBufferPacker(layout)
+	p.Float(1)
+	p.BeginStruct()
+	p.Float(2)
+	p.Vec2([]float64{3, 4})
+	p.EndStruct()
+	p.Float(5)
+	return p.Bytes()
+}
+
+func TestBufferPackerStruct(t *testing.T) {
+	testBufferOffsets(t, "std140 struct", testBufferStruct(Std140), 36, map[int]float64{
+		0: 1, 16: 2, 24: 3, 28: 4, 32: 5,
+	})
+	testBufferOffsets(t, "std430 struct", testBufferStruct(Std430), 28, map[int]float64{
+		0: 1, 8: 2, 16: 3, 20: 4, 24: 5,
+	})
+}
+
+func TestBufferUnpacker(t *testing.T) {
+	for _, layout := range []BufferLayout{Std140, Std430} {
+		u := NewBufferUnpacker(layout, testBufferBlock(layout))
+		var a, c float64
+		u.Float(&a)
+		b := u.Vec3(Vec3Create())
+		u.Float(&c)
+		d := u.Vec2(Vec2Create())
+		e := u.Mat3(Mat3Create())
+		f := u.FloatArray(make([]float64, 2))
+		if a != 1 || !testSlice(b, []float64{2, 3, 4}) || c != 5 || !testSlice(d, []float64{6, 7}) ||
+			!testSlice(e, []float64{8, 9, 10, 11, 12, 13, 14, 15, 16}) || !testSlice(f, []float64{17, 18}) {
+			t.Errorf("%v unpack: %v %v %v %v %v %v", layout, a, b, c, d, e, f)
+		}
+	}
+}
+
+func TestBufferUnpackerStruct(t *testing.T) {
+	for _, layout := range []BufferLayout{Std140, Std430} {
+		u := NewBufferUnpacker(layout, testBufferStruct(layout))
+		var a, b, d float64
+		u.Float(&a)
+		u.BeginStruct()
+		u.Float(&b)
+		c := u.Vec2(Vec2Create())
+		u.EndStruct()
+		u.Float(&d)
+		if a != 1 || b != 2 || !testSlice(c, []float64{3, 4}) || d != 5 {
+			t.Errorf("%v unpack struct: %v %v %v %v", layout, a, b, c, d)
+		}
+	}
+}
+
+func TestBufferRoundTrip(t *testing.T) {
+	m4 := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16}
+	v3s := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	for _, layout := range []BufferLayout{Std140, Std430} {
+		p := NewBufferPacker(layout)
+		for i := 0; i < 2; i++ {
+			p.BeginStruct()
+			p.Mat4(m4)
+			p.Vec3Array(v3s)
+			p.Mat2Array([]float64{1, 2, 3, 4, 5, 6, 7, 8})
+			p.EndStruct()
+		}
+		u := NewBufferUnpacker(layout, p.Bytes())
+		for i := 0; i < 2; i++ {
+			u.BeginStruct()
+			m := u.Mat4(Mat4Create())
+			v := u.Vec3Array(make([]float64, 9))
+			m2 := u.Mat2Array(make([]float64, 8))
+			u.EndStruct()
+			if !testSlice(m, m4) || !testSlice(v, v3s) || !testSlice(m2, []float64{1, 2, 3, 4, 5, 6, 7, 8}) {
+				t.Errorf("%v round trip: %v %v %v", layout, m, v, m2)
+			}
+		}
+	}
+}
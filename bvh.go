@@ -0,0 +1,475 @@
+package glmatrix
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// BVH is a skeleton and its motion read from a Biovision BVH file
+type BVH struct {
+	// Joints are in the order of the hierarchy, where a parent precedes its children
+	Joints []BVHJoint
+
+	// FrameTime is the duration of a frame in seconds
+	FrameTime float64
+
+	// Frames holds the values of all the channels of the joints for each frame
+	Frames [][]float64
+}
+
+// BVHJoint is a joint of the hierarchy of a BVH file. The angles of the
+// rotation channels are in degrees and the rotation is the product of the
+// rotations about each axis in channel order, e.g. Rz * Rx * Ry for the
+// channels Zrotation Xrotation Yrotation.
+type BVHJoint struct {
+	Name string
+
+	// Parent is the index of the parent joint, or -1 for a root
+	Parent int
+
+	// Offset is the translation from the parent joint
+	Offset []float64
+
+	// Channels are the names of the channels, e.g. Xposition or Zrotation
+	Channels []string
+
+	// EndSite is the offset of the end of a joint without children, or nil
+	EndSite []float64
+
+	// Index is the index of the first channel of the joint in a frame
+	Index int
+}
+
+// bvhChannels are the canonical names of the channels
+var bvhChannels = []string{"Xposition", "Yposition", "Zposition", "Xrotation", "Yrotation", "Zrotation"}
+
+// bvhTokenizer splits a BVH file into words keeping track of the line numbers
+type bvhTokenizer struct {
+	scanner *bufio.Scanner
+	line    int
+	words   []string
+}
+
+// peek returns the next word, or an empty string at the end of the file
+func (t *bvhTokenizer) peek() string {
+	for len(t.words) == 0 {
+		if !t.scanner.Scan() {
+			return ""
+		}
+		t.line++
+		t.words = strings.Fields(t.scanner.Text())
+	}
+	return t.words[0]
+}
+
+func (t *bvhTokenizer) next() string {
+	w := t.peek()
+	if w != "" {
+		t.words = t.words[1:]
+	}
+	return w
+}
+
+// rest returns the remaining words of the current line
+func (t *bvhTokenizer) rest() []string {
+	words := t.words
+	t.words = nil
+	return words
+}
+
+func (t *bvhTokenizer) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("glmatrix: invalid BVH at line %v: %v", t.line, fmt.Sprintf(format, args...))
+}
+
+func (t *bvhTokenizer) expect(word string) error {
+	if w := t.next(); w != word {
+		return t.errorf("expected %v but got %q", word, w)
+	}
+	return nil
+}
+
+func (t *bvhTokenizer) numbers(out []float64) error {
+	for i := range out {
+		w := t.next()
+		v, err := strconv.ParseFloat(w, 64)
+		if err != nil {
+			if w == "" {
+				return t.errorf("unexpected end of file")
+			}
+			return t.errorf("invalid number %q", w)
+		}
+		out[i] = v
+	}
+	return nil
+}
+
+// ReadBVH reads the HIERARCHY and MOTION sections of a BVH file. Multiple
+// roots are accepted and the names of the channels are case insensitive.
+func ReadBVH(r io.Reader) (*BVH, error) {
+	t := &bvhTokenizer{scanner: bufio.NewScanner(r)}
+	t.scanner.Buffer(nil, 1<<24)
+	b := &BVH{}
+	if err := t.expect("HIERARCHY"); err != nil {
+		return nil, err
+	}
+	channels := 0
+	var joint func(parent int) error
+	joint = func(parent int) error {
+		name := t.rest()
+		if len(name) > 0 && name[len(name)-1] == "{" {
+			name = name[:len(name)-1]
+			t.words = []string{"{"}
+		}
+		if len(name) == 0 {
+			return t.errorf("missing joint name")
+		}
+		index := len(b.Joints)
+		b.Joints = append(b.Joints, BVHJoint{Name: strings.Join(name, " "), Parent: parent, Offset: Vec3Create(), Index: channels})
+		j := &b.Joints[index]
+		if err := t.expect("{"); err != nil {
+			return err
+		}
+		if err := t.expect("OFFSET"); err != nil {
+			return err
+		}
+		if err := t.numbers(j.Offset); err != nil {
+			return err
+		}
+		if t.peek() == "CHANNELS" {
+			t.next()
+			n, err := strconv.Atoi(t.next())
+			if err != nil || n < 0 || n > len(bvhChannels) {
+				return t.errorf("invalid number of channels")
+			}
+			for i := 0; i < n; i++ {
+				w := t.next()
+				name := canonicalBVHChannel(w)
+				if name == "" {
+					return t.errorf("unknown channel %q", w)
+				}
+				for _, c := range j.Channels {
+					if c == name {
+						return t.errorf("duplicate channel %v", name)
+					}
+				}
+				j.Channels = append(j.Channels, name)
+			}
+			channels += n
+		}
+		for {
+			switch w := t.next(); w {
+			case "JOINT":
+				if err := joint(index); err != nil {
+					return err
+				}
+				// the slice may have grown
+				j = &b.Joints[index]
+			case "End":
+				if err := t.expect("Site"); err != nil {
+					return err
+				}
+				if j.EndSite != nil {
+					return t.errorf("duplicate End Site")
+				}
+				if err := t.expect("{"); err != nil {
+					return err
+				}
+				if err := t.expect("OFFSET"); err != nil {
+					return err
+				}
+				j.EndSite = Vec3Create()
+				if err := t.numbers(j.EndSite); err != nil {
+					return err
+				}
+				if err := t.expect("}"); err != nil {
+					return err
+				}
+			case "}":
+				return nil
+			default:
+				return t.errorf("expected JOINT, End Site or } but got %q", w)
+			}
+		}
+	}
+	for t.peek() == "ROOT" {
+		t.next()
+		if err := joint(-1); err != nil {
+			return nil, err
+		}
+	}
+	if len(b.Joints) == 0 {
+		return nil, t.errorf("missing ROOT")
+	}
+
+	if err := t.expect("MOTION"); err != nil {
+		return nil, err
+	}
+	if err := t.expect("Frames:"); err != nil {
+		return nil, err
+	}
+	frames, err := strconv.Atoi(t.next())
+	if err != nil || frames < 0 {
+		return nil, t.errorf("invalid number of frames")
+	}
+	if err := t.expect("Frame"); err != nil {
+		return nil, err
+	}
+	if err := t.expect("Time:"); err != nil {
+		return nil, err
+	}
+	frameTime := []float64{0}
+	if err := t.numbers(frameTime); err != nil {
+		return nil, err
+	}
+	b.FrameTime = frameTime[0]
+	b.Frames = make([][]float64, 0)
+	for i := 0; i < frames; i++ {
+		frame := make([]float64, channels)
+		if err := t.numbers(frame); err != nil {
+			return nil, fmt.Errorf("%v in frame %v of %v", err, i, frames)
+		}
+		b.Frames = append(b.Frames, frame)
+	}
+	if w := t.next(); w != "" {
+		return nil, t.errorf("unexpected %q after %v frames", w, frames)
+	}
+	if err := t.scanner.Err(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+func canonicalBVHChannel(name string) string {
+	for _, c := range bvhChannels {
+		if strings.EqualFold(name, c) {
+			return c
+		}
+	}
+	return ""
+}
+
+// ChannelCount returns the number of channels of a frame
+func (b *BVH) ChannelCount() int {
+	n := 0
+	for _, j := range b.Joints {
+		n += len(j.Channels)
+	}
+	return n
+}
+
+// rotationOrder returns the axis order of the rotation channels of a joint,
+// completed by the missing axes whose angles are zero
+func (j *BVHJoint) rotationOrder() AxisOrder {
+	order := ""
+	for _, c := range j.Channels {
+		if strings.HasSuffix(c, "rotation") {
+			order += strings.ToLower(c[0:1])
+		}
+	}
+	for _, axis := range []string{"x", "y", "z"} {
+		if !strings.Contains(order, axis) {
+			order += axis
+		}
+	}
+	return AxisOrder(order)
+}
+
+// JointTranslation calculates the translation of a joint from its parent in
+// a frame, which is the sum of the offset and the position channels
+func (b *BVH) JointTranslation(out []float64, frame, joint int) []float64 {
+	j := &b.Joints[joint]
+	Vec3Copy(out, j.Offset)
+	for i, c := range j.Channels {
+		if strings.HasSuffix(c, "position") {
+			out[c[0]-'X'] += b.Frames[frame][j.Index+i]
+		}
+	}
+	return out
+}
+
+// JointRotation calculates the rotation of a joint in a frame from its
+// rotation channels with QuatFromEulerWithOrder
+func (b *BVH) JointRotation(out []float64, frame, joint int) []float64 {
+	j := &b.Joints[joint]
+	angles := Vec3Create()
+	for i, c := range j.Channels {
+		if strings.HasSuffix(c, "rotation") {
+			angles[c[0]-'X'] = b.Frames[frame][j.Index+i]
+		}
+	}
+	return QuatFromEulerWithOrder(out, angles[0], angles[1], angles[2], j.rotationOrder())
+}
+
+// SetJointRotation sets the rotation channels of a joint in a frame to the
+// Euler angles of a quaternion in the order of the channels. A joint without
+// all three rotation channels can only represent rotations about its axes.
+func (b *BVH) SetJointRotation(frame, joint int, q []float64) {
+	j := &b.Joints[joint]
+	angles := eulerFromQuat(Vec3Create(), q, j.rotationOrder())
+	for i, c := range j.Channels {
+		if strings.HasSuffix(c, "rotation") {
+			b.Frames[frame][j.Index+i] = angles[c[0]-'X']
+		}
+	}
+}
+
+// LocalMatrix calculates the transformation of a joint in a frame from its
+// local space to the space of its parent
+func (b *BVH) LocalMatrix(out []float64, frame, joint int) []float64 {
+	q := b.JointRotation(QuatCreate(), frame, joint)
+	v := b.JointTranslation(Vec3Create(), frame, joint)
+	return Mat4FromRotationTranslation(out, q, v)
+}
+
+// WorldMatrices calculates the transformations of all the joints in a frame
+// from their local space to the space of the skeleton, indexed like Joints.
+// The origin of a joint transformed by its world matrix is its position.
+func (b *BVH) WorldMatrices(frame int) [][]float64 {
+	world := make([][]float64, len(b.Joints))
+	for i, j := range b.Joints {
+		world[i] = b.LocalMatrix(Mat4Create(), frame, i)
+		if j.Parent >= 0 {
+			Mat4Multiply(world[i], world[j.Parent], world[i])
+		}
+	}
+	return world
+}
+
+// WriteBVH writes a skeleton and its motion as a BVH file
+func WriteBVH(w io.Writer, b *BVH) error {
+	channels := 0
+	for i, j := range b.Joints {
+		if j.Parent >= i || j.Parent < -1 {
+			return fmt.Errorf("glmatrix: parent %v of BVH joint %v must precede it", j.Parent, i)
+		}
+		if j.Index != channels {
+			return fmt.Errorf("glmatrix: channels of BVH joint %v must start at %v but start at %v", i, channels, j.Index)
+		}
+		channels += len(j.Channels)
+	}
+	for i, frame := range b.Frames {
+		if len(frame) != channels {
+			return fmt.Errorf("glmatrix: BVH frame %v must have %v values but has %v", i, channels, len(frame))
+		}
+	}
+
+	bw := bufio.NewWriter(w)
+	vec := func(v []float64) string {
+		return formatDecimal(v[0]) + " " + formatDecimal(v[1]) + " " + formatDecimal(v[2])
+	}
+	var joint func(index, depth int)
+	joint = func(index, depth int) {
+		j := &b.Joints[index]
+		indent := strings.Repeat("\t", depth)
+		if j.Parent < 0 {
+			fmt.Fprintf(bw, "%vROOT %v\n", indent, j.Name)
+		} else {
+			fmt.Fprintf(bw, "%vJOINT %v\n", indent, j.Name)
+		}
+		fmt.Fprintf(bw, "%v{\n%v\tOFFSET %v\n", indent, indent, vec(j.Offset))
+		if len(j.Channels) > 0 {
+			fmt.Fprintf(bw, "%v\tCHANNELS %v %v\n", indent, len(j.Channels), strings.Join(j.Channels, " "))
+		}
+		for i := index + 1; i < len(b.Joints); i++ {
+			if b.Joints[i].Parent == index {
+				joint(i, depth+1)
+			}
+		}
+		if j.EndSite != nil {
+			fmt.Fprintf(bw, "%v\tEnd Site\n%v\t{\n%v\t\tOFFSET %v\n%v\t}\n", indent, indent, indent, vec(j.EndSite), indent)
+		}
+		fmt.Fprintf(bw, "%v}\n", indent)
+	}
+	bw.WriteString("HIERARCHY\n")
+	for i, j := range b.Joints {
+		if j.Parent < 0 {
+			joint(i, 0)
+		}
+	}
+	fmt.Fprintf(bw, "MOTION\nFrames: %v\nFrame Time: %v\n", len(b.Frames), formatDecimal(b.FrameTime))
+	for _, frame := range b.Frames {
+		for i, v := range frame {
+			if i > 0 {
+				bw.WriteByte(' ')
+			}
+			bw.WriteString(formatDecimal(v))
+		}
+		bw.WriteByte('\n')
+	}
+	return bw.Flush()
+}
+
+// eulerFromQuat calculates the Euler angles in degrees of a unit quaternion
+// for the given order, the inverse of QuatFromEulerWithOrder
+func eulerFromQuat(out, q []float64, order AxisOrder) []float64 {
+	m := Mat3FromQuat(Mat3Create(), q)
+	// mRC is the element of row R and column C
+	m11, m12, m13 := m[0], m[3], m[6]
+	m21, m22, m23 := m[1], m[4], m[7]
+	m31, m32, m33 := m[2], m[5], m[8]
+	clamp := func(v float64) float64 {
+		return math.Max(-1, math.Min(1, v))
+	}
+	const gimbal = 0.9999999
+	var x, y, z float64
+	switch order {
+	case XYZ:
+		y = math.Asin(clamp(m13))
+		if math.Abs(m13) < gimbal {
+			x = math.Atan2(-m23, m33)
+			z = math.Atan2(-m12, m11)
+		} else {
+			x = math.Atan2(m32, m22)
+		}
+	case YXZ:
+		x = math.Asin(-clamp(m23))
+		if math.Abs(m23) < gimbal {
+			y = math.Atan2(m13, m33)
+			z = math.Atan2(m21, m22)
+		} else {
+			y = math.Atan2(-m31, m11)
+		}
+	case ZXY:
+		x = math.Asin(clamp(m32))
+		if math.Abs(m32) < gimbal {
+			y = math.Atan2(-m31, m33)
+			z = math.Atan2(-m12, m22)
+		} else {
+			z = math.Atan2(m21, m11)
+		}
+	case ZYX:
+		y = math.Asin(-clamp(m31))
+		if math.Abs(m31) < gimbal {
+			x = math.Atan2(m32, m33)
+			z = math.Atan2(m21, m11)
+		} else {
+			z = math.Atan2(-m12, m22)
+		}
+	case YZX:
+		z = math.Asin(clamp(m21))
+		if math.Abs(m21) < gimbal {
+			x = math.Atan2(-m23, m22)
+			y = math.Atan2(-m31, m11)
+		} else {
+			y = math.Atan2(m13, m33)
+		}
+	case XZY:
+		z = math.Asin(-clamp(m12))
+		if math.Abs(m12) < gimbal {
+			x = math.Atan2(m32, m22)
+			y = math.Atan2(m13, m11)
+		} else {
+			x = math.Atan2(-m23, m33)
+		}
+	default:
+		panic(fmt.Sprintf("Unknown angle order %v", order))
+	}
+	out[0] = x / degree
+	out[1] = y / degree
+	out[2] = z / degree
+	return out
+}
@@ -0,0 +1,208 @@
+package glmatrix
+
+import (
+	"bytes"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+const testBVHSource = `HIERARCHY
+ROOT Hips
+{
+	OFFSET 0 0 0
+	CHANNELS 6 Xposition Yposition Zposition Zrotation Xrotation Yrotation
+	JOINT Left Leg
+	{
+		OFFSET 1 0 0
+		CHANNELS 3 zrotation xrotation yrotation
+		End Site
+		{
+			OFFSET 0 -2 0
+		}
+	}
+	JOINT Spine {
+		OFFSET 0 1 0
+		CHANNELS 1 Zrotation
+		End Site
+		{
+			OFFSET 0 3 0
+		}
+	}
+}
+MOTION
+Frames: 2
+Frame Time: 0.0333333
+0 10 0 0 0 0 0 0 0 0
+5 10 0
+90 0 0 0 0 90 90
+`
+
+func TestReadBVH(t *testing.T) {
+	b, err := ReadBVH(strings.NewReader(testBVHSource))
+	if err != nil {
+		t.Fatalf("read bvh: %v", err)
+	}
+	names := []string{"Hips", "Left Leg", "Spine"}
+	parents := []int{-1, 0, 0}
+	indices := []int{0, 6, 9}
+	for i, j := range b.Joints {
+		if j.Name != names[i] || j.Parent != parents[i] || j.Index != indices[i] {
+			t.Errorf("read bvh joint %v: %v", i, j)
+		}
+	}
+	if !testSlice(b.Joints[1].Offset, []float64{1, 0, 0}) || !testSlice(b.Joints[1].EndSite, []float64{0, -2, 0}) ||
+		b.Joints[0].EndSite != nil || strings.Join(b.Joints[1].Channels, " ") != "Zrotation Xrotation Yrotation" {
+		t.Errorf("read bvh joints: %v", b.Joints)
+	}
+	if b.ChannelCount() != 10 || b.FrameTime != 0.0333333 || len(b.Frames) != 2 ||
+		!testSlice(b.Frames[1], []float64{5, 10, 0, 90, 0, 0, 0, 0, 90, 90}) {
+		t.Errorf("read bvh motion: %v %v %v", b.ChannelCount(), b.FrameTime, b.Frames)
+	}
+}
+
+func TestBVHJointRotation(t *testing.T) {
+	b := &BVH{
+		Joints: []BVHJoint{{Parent: -1, Offset: Vec3Create(), Channels: []string{"Zrotation", "Xrotation", "Yrotation"}}},
+		Frames: [][]float64{{30, 40, 50}},
+	}
+	// the rotation is Rz * Rx * Ry
+	expect := QuatSetAxisAngle(QuatCreate(), []float64{0, 0, 1}, ToRadian(30))
+	QuatMultiply(expect, expect, QuatSetAxisAngle(QuatCreate(), []float64{1, 0, 0}, ToRadian(40)))
+	QuatMultiply(expect, expect, QuatSetAxisAngle(QuatCreate(), []float64{0, 1, 0}, ToRadian(50)))
+	actual := b.JointRotation(QuatCreate(), 0, 0)
+	if !testSlice(actual, expect) {
+		t.Errorf("joint rotation: %v", actual)
+	}
+
+	b.Joints[0].Channels = []string{"Yrotation", "Xposition"}
+	b.Frames[0] = []float64{90, 2}
+	actual = b.JointRotation(QuatCreate(), 0, 0)
+	if !testSlice(actual, QuatSetAxisAngle(QuatCreate(), []float64{0, 1, 0}, math.Pi/2)) {
+		t.Errorf("joint rotation y: %v", actual)
+	}
+	translation := b.JointTranslation(Vec3Create(), 0, 0)
+	if !testSlice(translation, []float64{2, 0, 0}) {
+		t.Errorf("joint translation: %v", translation)
+	}
+}
+
+func TestBVHSetJointRotation(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	orders := [][]string{
+		{"Xrotation", "Yrotation", "Zrotation"}, {"Xrotation", "Zrotation", "Yrotation"},
+		{"Yrotation", "Xrotation", "Zrotation"}, {"Yrotation", "Zrotation", "Xrotation"},
+		{"Zrotation", "Xrotation", "Yrotation"}, {"Zrotation", "Yrotation", "Xrotation"},
+	}
+	for _, channels := range orders {
+		b := &BVH{
+			Joints: []BVHJoint{{Parent: -1, Offset: Vec3Create(), Channels: channels}},
+			Frames: [][]float64{{0, 0, 0}},
+		}
+		for i := 0; i < 20; i++ {
+			q := QuatNormalize(QuatCreate(), []float64{r.NormFloat64(), r.NormFloat64(), r.NormFloat64(), r.NormFloat64()})
+			if i == 0 {
+				// gimbal lock
+				q = QuatFromEulerWithOrder(QuatCreate(), 10, 90, 20, XYZ)
+			}
+			b.SetJointRotation(0, 0, q)
+			actual := b.JointRotation(QuatCreate(), 0, 0)
+			// q and -q are the same rotation
+			if math.Abs(QuatDot(actual, q)) < 1-1e-9 {
+				t.Errorf("set joint rotation %v %v: %v", channels, q, actual)
+			}
+		}
+	}
+}
+
+func TestBVHWorldMatrices(t *testing.T) {
+	b, err := ReadBVH(strings.NewReader(testBVHSource))
+	if err != nil {
+		t.Fatalf("read bvh: %v", err)
+	}
+	cases := []struct {
+		frame  int
+		expect [][]float64
+	}{
+		{0, [][]float64{{0, 10, 0}, {1, 10, 0}, {0, 11, 0}}},
+		// the hips are rotated by 90 degrees about z, the leg about y and the spine about z
+		{1, [][]float64{{5, 10, 0}, {5, 11, 0}, {4, 10, 0}}},
+	}
+	for _, c := range cases {
+		world := b.WorldMatrices(c.frame)
+		for i, expect := range c.expect {
+			p := Vec3TransformMat4(Vec3Create(), Vec3Create(), world[i])
+			if !testSlice(p, expect) {
+				t.Errorf("world matrices frame %v joint %v: %v", c.frame, i, p)
+			}
+		}
+	}
+	// the end site of the leg points along -x after rotating the leg about y
+	world := b.WorldMatrices(1)
+	p := Vec3TransformMat4(Vec3Create(), b.Joints[1].EndSite, world[1])
+	if !testSlice(p, []float64{7, 11, 0}) {
+		t.Errorf("world matrices end site: %v", p)
+	}
+}
+
+func TestBVHRoundTrip(t *testing.T) {
+	b, err := ReadBVH(strings.NewReader(testBVHSource))
+	if err != nil {
+		t.Fatalf("read bvh: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := WriteBVH(&buf, b); err != nil {
+		t.Fatalf("write bvh: %v", err)
+	}
+	expect := strings.Replace(strings.Replace(testBVHSource, "zrotation xrotation yrotation", "Zrotation Xrotation Yrotation", 1),
+		"JOINT Spine {", "JOINT Spine\n\t{", 1)
+	expect = strings.Replace(expect, "0 10 0 0 0 0 0 0 0 0\n5 10 0\n90", "0 10 0 0 0 0 0 0 0 0\n5 10 0 90", 1)
+	if buf.String() != expect {
+		t.Errorf("write bvh: %v", buf.String())
+	}
+	actual, err := ReadBVH(&buf)
+	if err != nil || len(actual.Joints) != 3 || !testSlice(actual.Frames[1], b.Frames[1]) {
+		t.Errorf("bvh round trip: %v %v", actual, err)
+	}
+}
+
+func TestReadBVHErrors(t *testing.T) {
+	cases := map[string]string{
+		"":                                     "line 0: expected HIERARCHY",
+		"HIERARCHY\nMOTION\n":                  "line 2: missing ROOT",
+		"HIERARCHY\nROOT\n{\n":                 "line 2: missing joint name",
+		"HIERARCHY\nROOT a\nOFFSET":            "line 3: expected {",
+		"HIERARCHY\nROOT a\n{\nOFFSET 0 x 0\n": `line 4: invalid number "x"`,
+		"HIERARCHY\nROOT a\n{\nOFFSET 0 0 0\nCHANNELS 7\n":                                                     "line 5: invalid number of channels",
+		"HIERARCHY\nROOT a\n{\nOFFSET 0 0 0\nCHANNELS 1 Wrotation\n":                                           `line 5: unknown channel "Wrotation"`,
+		"HIERARCHY\nROOT a\n{\nOFFSET 0 0 0\nCHANNELS 2 Xrotation xrotation\n":                                 "line 5: duplicate channel Xrotation",
+		"HIERARCHY\nROOT a\n{\nOFFSET 0 0 0\nEnd Site\n{\nOFFSET 0 1 0\n}\nEnd Site\n":                         "line 9: duplicate End Site",
+		"HIERARCHY\nROOT a\n{\nOFFSET 0 0 0\nJOIN b\n":                                                         `line 5: expected JOINT, End Site or } but got "JOIN"`,
+		"HIERARCHY\nROOT a\n{\nOFFSET 0 0 0\n}\nMOTION\nFrames: -1\n":                                          "line 7: invalid number of frames",
+		"HIERARCHY\nROOT a\n{\nOFFSET 0 0 0\nCHANNELS 1 Xposition\n}\nMOTION\nFrames: 2\nFrame Time: 0.1\n1\n": "line 10: unexpected end of file in frame 1 of 2",
+		"HIERARCHY\nROOT a\n{\nOFFSET 0 0 0\n}\nMOTION\nFrames: 0\nFrame Time: 0.1\n1\n":                       `line 9: unexpected "1" after 0 frames`,
+	}
+	for src, expect := range cases {
+		b, err := ReadBVH(strings.NewReader(src))
+		if b != nil || err == nil || !strings.Contains(err.Error(), expect) {
+			t.Errorf("read bvh %q: %v", src, err)
+		}
+	}
+}
+
+func TestWriteBVHErrors(t *testing.T) {
+	cases := map[string]*BVH{
+		"parent 1 of BVH joint 0 must precede it": {Joints: []BVHJoint{{Parent: 1, Offset: Vec3Create()}}},
+		"channels of BVH joint 1 must start at 1 but start at 0": {Joints: []BVHJoint{
+			{Parent: -1, Offset: Vec3Create(), Channels: []string{"Xposition"}},
+			{Parent: 0, Offset: Vec3Create(), Channels: []string{"Xposition"}},
+		}},
+		"BVH frame 0 must have 0 values but has 1": {Joints: []BVHJoint{{Parent: -1, Offset: Vec3Create()}}, Frames: [][]float64{{1}}},
+	}
+	for expect, b := range cases {
+		if err := WriteBVH(&bytes.Buffer{}, b); err == nil || !strings.Contains(err.Error(), expect) {
+			t.Errorf("write bvh %v: %v", expect, err)
+		}
+	}
+}
@@ -23,3 +23,217 @@ func hypot(vals ...float64) float64 {
 	}
 	return math.Sqrt(sum)
 }
+
+// symmetricEigen calculates the eigenvalues and eigenvectors of a symmetric
+// n x n matrix by the cyclic Jacobi method. The eigenvectors are returned as
+// the columns of a column-major matrix and the matrix a is left untouched.
+func symmetricEigen(a []float64, n int) ([]float64, []float64) {
+	m := make([]float64, n*n)
+	copy(m, a)
+	v := make([]float64, n*n)
+	for i := 0; i < n; i++ {
+		v[i*n+i] = 1
+	}
+
+	for sweep := 0; sweep < 50; sweep++ {
+		off := 0.
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				off += m[p*n+q] * m[p*n+q]
+			}
+		}
+		if off < 1e-30 {
+			break
+		}
+		for p := 0; p < n; p++ {
+			for q := p + 1; q < n; q++ {
+				apq := m[q*n+p]
+				if apq == 0 {
+					continue
+				}
+				theta := (m[q*n+q] - m[p*n+p]) / (2 * apq)
+				t := 1 / (math.Abs(theta) + math.Sqrt(theta*theta+1))
+				if theta < 0 {
+					t = -t
+				}
+				c := 1 / math.Sqrt(t*t+1)
+				s := t * c
+				for k := 0; k < n; k++ {
+					mkp := m[p*n+k]
+					mkq := m[q*n+k]
+					m[p*n+k] = c*mkp - s*mkq
+					m[q*n+k] = s*mkp + c*mkq
+				}
+				for k := 0; k < n; k++ {
+					mpk := m[k*n+p]
+					mqk := m[k*n+q]
+					m[k*n+p] = c*mpk - s*mqk
+					m[k*n+q] = s*mpk + c*mqk
+				}
+				for k := 0; k < n; k++ {
+					vkp := v[p*n+k]
+					vkq := v[q*n+k]
+					v[p*n+k] = c*vkp - s*vkq
+					v[q*n+k] = s*vkp + c*vkq
+				}
+			}
+		}
+	}
+
+	values := make([]float64, n)
+	for i := 0; i < n; i++ {
+		values[i] = m[i*n+i]
+	}
+	return values, v
+}
+
+// solveLinear solves the n x n linear system a * x = b by gaussian
+// elimination with partial pivoting. The matrix a is column-major.
+// It returns nil if the system is singular. Neither a nor b is modified.
+func solveLinear(a, b []float64, n int) []float64 {
+	m := make([]float64, n*n)
+	copy(m, a)
+	x := make([]float64, n)
+	copy(x, b)
+
+	for col := 0; col < n; col++ {
+		pivot := col
+		for row := col + 1; row < n; row++ {
+			if math.Abs(m[col*n+row]) > math.Abs(m[col*n+pivot]) {
+				pivot = row
+			}
+		}
+		if math.Abs(m[col*n+pivot]) < 1e-12 {
+			return nil
+		}
+		if pivot != col {
+			for k := 0; k < n; k++ {
+				m[k*n+col], m[k*n+pivot] = m[k*n+pivot], m[k*n+col]
+			}
+			x[col], x[pivot] = x[pivot], x[col]
+		}
+		for row := col + 1; row < n; row++ {
+			f := m[col*n+row] / m[col*n+col]
+			for k := col; k < n; k++ {
+				m[k*n+row] -= f * m[k*n+col]
+			}
+			x[row] -= f * x[col]
+		}
+	}
+	for row := n - 1; row >= 0; row-- {
+		sum := x[row]
+		for k := row + 1; k < n; k++ {
+			sum -= m[k*n+row] * x[k]
+		}
+		x[row] = sum / m[row*n+row]
+	}
+	return x
+}
+
+func step(edge, x float64) float64 {
+	if x < edge {
+		return 0
+	}
+	return 1
+}
+
+func smoothstep(edge0, edge1, x float64) float64 {
+	t := math.Min(math.Max((x-edge0)/(edge1-edge0), 0), 1)
+	return t * t * (3 - 2*t)
+}
+
+func fract(x float64) float64 {
+	return x - math.Floor(x)
+}
+
+func mod(x, y float64) float64 {
+	return x - y*math.Floor(x/y)
+}
+
+func sign(x float64) float64 {
+	if x > 0 {
+		return 1
+	} else if x < 0 {
+		return -1
+	}
+	return 0
+}
+
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+func absInt(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// ivecHash mixes the components of an integer vector into a 64 bit hash
+func ivecHash(a []int) uint64 {
+	h := uint64(len(a))
+	for _, v := range a {
+		h ^= uint64(v)
+		h *= 0x9e3779b97f4a7c15
+		h ^= h >> 32
+	}
+	h *= 0xbf58476d1ce4e5b9
+	return h ^ h>>31
+}
+
+// gridTraversal walks the cells of an n dimensional grid pierced by a ray
+// with the Amanatides-Woo algorithm
+func gridTraversal(origin, direction []float64, n int, cellSize, maxDistance float64, fn func(cell []int, t float64) bool) {
+	length := 0.
+	for i := 0; i < n; i++ {
+		length += direction[i] * direction[i]
+	}
+	length = math.Sqrt(length)
+
+	cell := make([]int, n)
+	stepDir := make([]int, n)
+	tMax := make([]float64, n)
+	tDelta := make([]float64, n)
+	for i := 0; i < n; i++ {
+		cell[i] = int(math.Floor(origin[i] / cellSize))
+		tMax[i] = math.Inf(1)
+		tDelta[i] = math.Inf(1)
+		if length == 0 {
+			continue
+		}
+		d := direction[i] / length
+		if d > 0 {
+			stepDir[i] = 1
+			tMax[i] = (float64(cell[i]+1)*cellSize - origin[i]) / d
+			tDelta[i] = cellSize / d
+		} else if d < 0 {
+			stepDir[i] = -1
+			tMax[i] = (float64(cell[i])*cellSize - origin[i]) / d
+			tDelta[i] = -cellSize / d
+		}
+	}
+
+	t := 0.
+	for t <= maxDistance {
+		if !fn(cell, t) {
+			return
+		}
+		axis := 0
+		for i := 1; i < n; i++ {
+			if tMax[i] < tMax[axis] {
+				axis = i
+			}
+		}
+		if math.IsInf(tMax[axis], 1) {
+			return
+		}
+		t = tMax[axis]
+		cell[axis] += stepDir[axis]
+		tMax[axis] += tDelta[axis]
+	}
+}
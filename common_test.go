@@ -24,3 +24,48 @@ func TestToRadian(t *testing.T) {
 		t.Errorf("deg:90 rad:%v", actual)
 	}
 }
+
+func testSliceTol(a, b []float64, tol float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, ai := range a {
+		if math.Abs(ai-b[i]) > tol {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSymmetricEigen(t *testing.T) {
+	a := []float64{
+		4, 1, 2,
+		1, 3, 0,
+		2, 0, 5,
+	}
+	values, vectors := symmetricEigen(a, 3)
+	for k := 0; k < 3; k++ {
+		v := vectors[k*3 : k*3+3]
+		actual := Vec3TransformMat3(Vec3Create(), v, a)
+		expect := Vec3Scale(Vec3Create(), v, values[k])
+		if !testSlice(actual, expect) {
+			t.Errorf("symmetric eigen %v: %v %v", k, actual, expect)
+		}
+	}
+}
+
+func TestSolveLinear(t *testing.T) {
+	a := []float64{
+		0, 1, 2,
+		1, 0, 1,
+		3, 1, 0,
+	}
+	actual := solveLinear(a, []float64{5, 3, 4}, 3)
+	expect := Vec3TransformMat3(Vec3Create(), actual, a)
+	if !testSlice(expect, []float64{5, 3, 4}) {
+		t.Errorf("solve linear: %v", actual)
+	}
+	if solveLinear([]float64{1, 2, 2, 4}, []float64{1, 1}, 2) != nil {
+		t.Errorf("solve linear singular")
+	}
+}
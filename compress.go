@@ -0,0 +1,452 @@
+package glmatrix
+
+import "math"
+
+// The encoders below quantize vertex attributes. The maximum errors of the
+// values decoded from the encoded ones are:
+//
+// - float16: a relative error of 2^-11 within the normal range
+//   [6.1e-5, 65504], an absolute error of 2^-25 below it
+// - 8 bit snorm: 1/254, 8 bit unorm: 1/510
+// - 16 bit snorm: 1/65534, 16 bit unorm: 1/131070
+// - 10-10-10-2 unorm: 1/2046 for x, y and z, 1/6 for w
+// - 10-10-10-2 snorm: 1/1022 for x, y and z, 1/2 for w
+// - octahedral with 16 bit snorm components: 0.0001 rad,
+//   with 8 bit snorm components: 0.02 rad
+// - smallest three quaternion: 0.005 rad
+//
+// Values outside the range of a normalized format are clamped.
+
+// ToHalf converts a float64 into the nearest IEEE 754 half precision float
+func ToHalf(f float64) uint16 {
+	b := math.Float64bits(f)
+	sign := uint16(b>>48) & 0x8000
+	exp := int(b>>52) & 0x7ff
+	mant := b & (1<<52 - 1)
+	if exp == 0x7ff {
+		if mant != 0 {
+			return sign | 0x7e00
+		}
+		return sign | 0x7c00
+	}
+	e := exp - 1023 + 15
+	if e >= 0x1f {
+		return sign | 0x7c00
+	}
+	shift := uint(42)
+	if e <= 0 {
+		// subnormal half
+		if e < -10 {
+			return sign
+		}
+		mant |= 1 << 52
+		shift = uint(43 - e)
+		e = 0
+	}
+	h := uint64(e)<<10 | mant>>shift
+	rem := mant & (1<<shift - 1)
+	half := uint64(1) << (shift - 1)
+	if rem > half || (rem == half && h&1 == 1) {
+		// a carry into the exponent is still correctly rounded
+		h++
+	}
+	return sign | uint16(h)
+}
+
+// FromHalf converts an IEEE 754 half precision float into a float64
+func FromHalf(h uint16) float64 {
+	sign := 1.
+	if h&0x8000 != 0 {
+		sign = -1
+	}
+	exp := int(h>>10) & 0x1f
+	mant := float64(h & 0x3ff)
+	switch exp {
+	case 0:
+		return sign * math.Ldexp(mant, -24)
+	case 0x1f:
+		if mant != 0 {
+			return math.NaN()
+		}
+		return math.Inf(int(sign))
+	}
+	return sign * math.Ldexp(mant+1024, exp-25)
+}
+
+func snorm(x, max float64) float64 {
+	return math.Round(math.Min(math.Max(x, -1), 1) * max)
+}
+
+func unsnorm(x, max float64) float64 {
+	return math.Max(x/max, -1)
+}
+
+func unorm(x, max float64) float64 {
+	return math.Round(math.Min(math.Max(x, 0), 1) * max)
+}
+
+// Vec4ToUnorm1010102 packs a vec4 into 10 bit unorm x, y and z and 2 bit unorm w,
+// with x in the lowest bits
+func Vec4ToUnorm1010102(a []float64) uint32 {
+	return uint32(unorm(a[0], 1023)) |
+		uint32(unorm(a[1], 1023))<<10 |
+		uint32(unorm(a[2], 1023))<<20 |
+		uint32(unorm(a[3], 3))<<30
+}
+
+// Vec4FromUnorm1010102 unpacks a vec4 packed by Vec4ToUnorm1010102
+func Vec4FromUnorm1010102(out []float64, v uint32) []float64 {
+	out[0] = float64(v&0x3ff) / 1023
+	out[1] = float64(v>>10&0x3ff) / 1023
+	out[2] = float64(v>>20&0x3ff) / 1023
+	out[3] = float64(v>>30) / 3
+	return out
+}
+
+// Vec4ToSnorm1010102 packs a vec4 into 10 bit snorm x, y and z and 2 bit snorm w,
+// with x in the lowest bits
+func Vec4ToSnorm1010102(a []float64) uint32 {
+	return uint32(int32(snorm(a[0], 511)))&0x3ff |
+		(uint32(int32(snorm(a[1], 511)))&0x3ff)<<10 |
+		(uint32(int32(snorm(a[2], 511)))&0x3ff)<<20 |
+		uint32(int32(snorm(a[3], 1)))<<30
+}
+
+// Vec4FromSnorm1010102 unpacks a vec4 packed by Vec4ToSnorm1010102
+func Vec4FromSnorm1010102(out []float64, v uint32) []float64 {
+	out[0] = unsnorm(float64(int32(v<<22)>>22), 511)
+	out[1] = unsnorm(float64(int32(v<<12)>>22), 511)
+	out[2] = unsnorm(float64(int32(v<<2)>>22), 511)
+	out[3] = unsnorm(float64(int32(v)>>30), 1)
+	return out
+}
+
+func signNotZero(x float64) float64 {
+	if x < 0 {
+		return -1
+	}
+	return 1
+}
+
+// Vec3ToOctahedral encodes a unit Vec3 into a vec2 in [-1, 1] by projecting it
+// onto an octahedron unfolded onto a square. The vec2 is usually stored
+// as 8 or 16 bit snorm.
+func Vec3ToOctahedral(out, a []float64) []float64 {
+	l := math.Abs(a[0]) + math.Abs(a[1]) + math.Abs(a[2])
+	if l == 0 {
+		out[0] = 0
+		out[1] = 0
+		return out
+	}
+	x := a[0] / l
+	y := a[1] / l
+	if a[2] < 0 {
+		x, y = (1-math.Abs(y))*signNotZero(x), (1-math.Abs(x))*signNotZero(y)
+	}
+	out[0] = x
+	out[1] = y
+	return out
+}
+
+// Vec3FromOctahedral decodes a unit Vec3 from a vec2 encoded by Vec3ToOctahedral
+func Vec3FromOctahedral(out, a []float64) []float64 {
+	x := a[0]
+	y := a[1]
+	z := 1 - math.Abs(x) - math.Abs(y)
+	if z < 0 {
+		x, y = (1-math.Abs(y))*signNotZero(x), (1-math.Abs(x))*signNotZero(y)
+	}
+	out[0] = x
+	out[1] = y
+	out[2] = z
+	return Vec3Normalize(out, out)
+}
+
+// QuatToSmallestThree packs a unit quat into 32 bits by dropping its largest
+// component, which is recovered from the unit length, and storing the other
+// three as 10 bit values. The index of the dropped component is stored in the
+// highest 2 bits.
+func QuatToSmallestThree(a []float64) uint32 {
+	largest := 0
+	for i := 1; i < 4; i++ {
+		if math.Abs(a[i]) > math.Abs(a[largest]) {
+			largest = i
+		}
+	}
+	s := 1.
+	if a[largest] < 0 {
+		// q and -q represent the same rotation
+		s = -1
+	}
+	v := uint32(largest) << 30
+	shift := uint(20)
+	for i := 0; i < 4; i++ {
+		if i == largest {
+			continue
+		}
+		// the other components lie in [-1/sqrt(2), 1/sqrt(2)] and are quantized
+		// to an odd number of levels so that zero is exact
+		c := (s*a[i]*math.Sqrt2 + 1) / 2
+		v |= uint32(unorm(c, 1022)) << shift
+		shift -= 10
+	}
+	return v
+}
+
+// QuatFromSmallestThree unpacks a quat packed by QuatToSmallestThree
+func QuatFromSmallestThree(out []float64, v uint32) []float64 {
+	largest := int(v >> 30)
+	shift := uint(20)
+	sum := 0.
+	for i := 0; i < 4; i++ {
+		if i == largest {
+			continue
+		}
+		c := (float64(v>>shift&0x3ff)/1022*2 - 1) / math.Sqrt2
+		out[i] = c
+		sum += c * c
+		shift -= 10
+	}
+	out[largest] = math.Sqrt(math.Max(1-sum, 0))
+	return QuatNormalize(out, out)
+}
+
+// Vec2ToHalf encodes the components of a vec2 as float16
+func Vec2ToHalf(out []uint16, a []float64) []uint16 {
+	out[0] = ToHalf(a[0])
+	out[1] = ToHalf(a[1])
+	return out
+}
+
+// Vec2FromHalf decodes a vec2 from float16 components
+func Vec2FromHalf(out []float64, a []uint16) []float64 {
+	out[0] = FromHalf(a[0])
+	out[1] = FromHalf(a[1])
+	return out
+}
+
+// Vec3ToHalf encodes the components of a Vec3 as float16
+func Vec3ToHalf(out []uint16, a []float64) []uint16 {
+	out[0] = ToHalf(a[0])
+	out[1] = ToHalf(a[1])
+	out[2] = ToHalf(a[2])
+	return out
+}
+
+// Vec3FromHalf decodes a Vec3 from float16 components
+func Vec3FromHalf(out []float64, a []uint16) []float64 {
+	out[0] = FromHalf(a[0])
+	out[1] = FromHalf(a[1])
+	out[2] = FromHalf(a[2])
+	return out
+}
+
+// Vec4ToHalf encodes the components of a vec4 as float16
+func Vec4ToHalf(out []uint16, a []float64) []uint16 {
+	out[0] = ToHalf(a[0])
+	out[1] = ToHalf(a[1])
+	out[2] = ToHalf(a[2])
+	out[3] = ToHalf(a[3])
+	return out
+}
+
+// Vec4FromHalf decodes a vec4 from float16 components
+func Vec4FromHalf(out []float64, a []uint16) []float64 {
+	out[0] = FromHalf(a[0])
+	out[1] = FromHalf(a[1])
+	out[2] = FromHalf(a[2])
+	out[3] = FromHalf(a[3])
+	return out
+}
+
+// Vec2ToSnorm8 encodes the components of a vec2 as 8 bit snorm
+func Vec2ToSnorm8(out []int8, a []float64) []int8 {
+	out[0] = int8(snorm(a[0], 127))
+	out[1] = int8(snorm(a[1], 127))
+	return out
+}
+
+// Vec2FromSnorm8 decodes a vec2 from 8 bit snorm components
+func Vec2FromSnorm8(out []float64, a []int8) []float64 {
+	out[0] = unsnorm(float64(a[0]), 127)
+	out[1] = unsnorm(float64(a[1]), 127)
+	return out
+}
+
+// Vec3ToSnorm8 encodes the components of a Vec3 as 8 bit snorm
+func Vec3ToSnorm8(out []int8, a []float64) []int8 {
+	out[0] = int8(snorm(a[0], 127))
+	out[1] = int8(snorm(a[1], 127))
+	out[2] = int8(snorm(a[2], 127))
+	return out
+}
+
+// Vec3FromSnorm8 decodes a Vec3 from 8 bit snorm components
+func Vec3FromSnorm8(out []float64, a []int8) []float64 {
+	out[0] = unsnorm(float64(a[0]), 127)
+	out[1] = unsnorm(float64(a[1]), 127)
+	out[2] = unsnorm(float64(a[2]), 127)
+	return out
+}
+
+// Vec4ToSnorm8 encodes the components of a vec4 as 8 bit snorm
+func Vec4ToSnorm8(out []int8, a []float64) []int8 {
+	out[0] = int8(snorm(a[0], 127))
+	out[1] = int8(snorm(a[1], 127))
+	out[2] = int8(snorm(a[2], 127))
+	out[3] = int8(snorm(a[3], 127))
+	return out
+}
+
+// Vec4FromSnorm8 decodes a vec4 from 8 bit snorm components
+func Vec4FromSnorm8(out []float64, a []int8) []float64 {
+	out[0] = unsnorm(float64(a[0]), 127)
+	out[1] = unsnorm(float64(a[1]), 127)
+	out[2] = unsnorm(float64(a[2]), 127)
+	out[3] = unsnorm(float64(a[3]), 127)
+	return out
+}
+
+// Vec2ToUnorm8 encodes the components of a vec2 as 8 bit unorm
+func Vec2ToUnorm8(out []uint8, a []float64) []uint8 {
+	out[0] = uint8(unorm(a[0], 255))
+	out[1] = uint8(unorm(a[1], 255))
+	return out
+}
+
+// Vec2FromUnorm8 decodes a vec2 from 8 bit unorm components
+func Vec2FromUnorm8(out []float64, a []uint8) []float64 {
+	out[0] = float64(a[0]) / 255
+	out[1] = float64(a[1]) / 255
+	return out
+}
+
+// Vec3ToUnorm8 encodes the components of a Vec3 as 8 bit unorm
+func Vec3ToUnorm8(out []uint8, a []float64) []uint8 {
+	out[0] = uint8(unorm(a[0], 255))
+	out[1] = uint8(unorm(a[1], 255))
+	out[2] = uint8(unorm(a[2], 255))
+	return out
+}
+
+// Vec3FromUnorm8 decodes a Vec3 from 8 bit unorm components
+func Vec3FromUnorm8(out []float64, a []uint8) []float64 {
+	out[0] = float64(a[0]) / 255
+	out[1] = float64(a[1]) / 255
+	out[2] = float64(a[2]) / 255
+	return out
+}
+
+// Vec4ToUnorm8 encodes the components of a vec4 as 8 bit unorm
+func Vec4ToUnorm8(out []uint8, a []float64) []uint8 {
+	out[0] = uint8(unorm(a[0], 255))
+	out[1] = uint8(unorm(a[1], 255))
+	out[2] = uint8(unorm(a[2], 255))
+	out[3] = uint8(unorm(a[3], 255))
+	return out
+}
+
+// Vec4FromUnorm8 decodes a vec4 from 8 bit unorm components
+func Vec4FromUnorm8(out []float64, a []uint8) []float64 {
+	out[0] = float64(a[0]) / 255
+	out[1] = float64(a[1]) / 255
+	out[2] = float64(a[2]) / 255
+	out[3] = float64(a[3]) / 255
+	return out
+}
+
+// Vec2ToSnorm16 encodes the components of a vec2 as 16 bit snorm
+func Vec2ToSnorm16(out []int16, a []float64) []int16 {
+	out[0] = int16(snorm(a[0], 32767))
+	out[1] = int16(snorm(a[1], 32767))
+	return out
+}
+
+// Vec2FromSnorm16 decodes a vec2 from 16 bit snorm components
+func Vec2FromSnorm16(out []float64, a []int16) []float64 {
+	out[0] = unsnorm(float64(a[0]), 32767)
+	out[1] = unsnorm(float64(a[1]), 32767)
+	return out
+}
+
+// Vec3ToSnorm16 encodes the components of a Vec3 as 16 bit snorm
+func Vec3ToSnorm16(out []int16, a []float64) []int16 {
+	out[0] = int16(snorm(a[0], 32767))
+	out[1] = int16(snorm(a[1], 32767))
+	out[2] = int16(snorm(a[2], 32767))
+	return out
+}
+
+// Vec3FromSnorm16 decodes a Vec3 from 16 bit snorm components
+func Vec3FromSnorm16(out []float64, a []int16) []float64 {
+	out[0] = unsnorm(float64(a[0]), 32767)
+	out[1] = unsnorm(float64(a[1]), 32767)
+	out[2] = unsnorm(float64(a[2]), 32767)
+	return out
+}
+
+// Vec4ToSnorm16 encodes the components of a vec4 as 16 bit snorm
+func Vec4ToSnorm16(out []int16, a []float64) []int16 {
+	out[0] = int16(snorm(a[0], 32767))
+	out[1] = int16(snorm(a[1], 32767))
+	out[2] = int16(snorm(a[2], 32767))
+	out[3] = int16(snorm(a[3], 32767))
+	return out
+}
+
+// Vec4FromSnorm16 decodes a vec4 from 16 bit snorm components
+func Vec4FromSnorm16(out []float64, a []int16) []float64 {
+	out[0] = unsnorm(float64(a[0]), 32767)
+	out[1] = unsnorm(float64(a[1]), 32767)
+	out[2] = unsnorm(float64(a[2]), 32767)
+	out[3] = unsnorm(float64(a[3]), 32767)
+	return out
+}
+
+// Vec2ToUnorm16 encodes the components of a vec2 as 16 bit unorm
+func Vec2ToUnorm16(out []uint16, a []float64) []uint16 {
+	out[0] = uint16(unorm(a[0], 65535))
+	out[1] = uint16(unorm(a[1], 65535))
+	return out
+}
+
+// Vec2FromUnorm16 decodes a vec2 from 16 bit unorm components
+func Vec2FromUnorm16(out []float64, a []uint16) []float64 {
+	out[0] = float64(a[0]) / 65535
+	out[1] = float64(a[1]) / 65535
+	return out
+}
+
+// Vec3ToUnorm16 encodes the components of a Vec3 as 16 bit unorm
+func Vec3ToUnorm16(out []uint16, a []float64) []uint16 {
+	out[0] = uint16(unorm(a[0], 65535))
+	out[1] = uint16(unorm(a[1], 65535))
+	out[2] = uint16(unorm(a[2], 65535))
+	return out
+}
+
+// Vec3FromUnorm16 decodes a Vec3 from 16 bit unorm components
+func Vec3FromUnorm16(out []float64, a []uint16) []float64 {
+	out[0] = float64(a[0]) / 65535
+	out[1] = float64(a[1]) / 65535
+	out[2] = float64(a[2]) / 65535
+	return out
+}
+
+// Vec4ToUnorm16 encodes the components of a vec4 as 16 bit unorm
+func Vec4ToUnorm16(out []uint16, a []float64) []uint16 {
+	out[0] = uint16(unorm(a[0], 65535))
+	out[1] = uint16(unorm(a[1], 65535))
+	out[2] = uint16(unorm(a[2], 65535))
+	out[3] = uint16(unorm(a[3], 65535))
+	return out
+}
+
+// Vec4FromUnorm16 decodes a vec4 from 16 bit unorm components
+func Vec4FromUnorm16(out []float64, a []uint16) []float64 {
+	out[0] = float64(a[0]) / 65535
+	out[1] = float64(a[1]) / 65535
+	out[2] = float64(a[2]) / 65535
+	out[3] = float64(a[3]) / 65535
+	return out
+}
@@ -0,0 +1,201 @@
+package glmatrix
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestToHalf(t *testing.T) {
+	cases := map[float64]uint16{
+		0:                  0x0000,
+		1:                  0x3c00,
+		-2:                 0xc000,
+		0.5:                0x3800,
+		65504:              0x7bff,
+		65520:              0x7c00,
+		math.Inf(1):        0x7c00,
+		math.Inf(-1):       0xfc00,
+		math.Ldexp(1, -14): 0x0400,
+		math.Ldexp(1, -24): 0x0001,
+		math.Ldexp(1, -26): 0x0000,
+		1 + 1./2048:        0x3c00,
+		1 + 3./2048:        0x3c02,
+	}
+	for f, expect := range cases {
+		if actual := ToHalf(f); actual != expect {
+			t.Errorf("to half %v: %#04x", f, actual)
+		}
+	}
+	if actual := ToHalf(math.NaN()); actual&0x7c00 != 0x7c00 || actual&0x3ff == 0 {
+		t.Errorf("to half NaN: %#04x", actual)
+	}
+}
+
+func TestFromHalf(t *testing.T) {
+	cases := map[uint16]float64{
+		0x3c00: 1,
+		0xc000: -2,
+		0x7bff: 65504,
+		0x0001: math.Ldexp(1, -24),
+		0x03ff: math.Ldexp(1023, -24),
+		0x7c00: math.Inf(1),
+	}
+	for h, expect := range cases {
+		if actual := FromHalf(h); actual != expect {
+			t.Errorf("from half %#04x: %v", h, actual)
+		}
+	}
+	if actual := FromHalf(0x7e00); !math.IsNaN(actual) {
+		t.Errorf("from half NaN: %v", actual)
+	}
+	for h := 0; h < 0x7c00; h++ {
+		if actual := ToHalf(FromHalf(uint16(h))); actual != uint16(h) {
+			t.Errorf("half round trip %#04x: %#04x", h, actual)
+		}
+	}
+}
+
+func TestVec3Half(t *testing.T) {
+	a := []float64{1.1, -200.3, 0.001}
+	actual := Vec3FromHalf(Vec3Create(), Vec3ToHalf(make([]uint16, 3), a))
+	for i := range a {
+		if math.Abs(actual[i]-a[i]) > math.Abs(a[i])*math.Ldexp(1, -11) {
+			t.Errorf("half: %v", actual)
+		}
+	}
+}
+
+func TestVec4Snorm8(t *testing.T) {
+	encoded := Vec4ToSnorm8(make([]int8, 4), []float64{-1, 1, 0.5, 2})
+	if encoded[0] != -127 || encoded[1] != 127 || encoded[2] != 64 || encoded[3] != 127 {
+		t.Errorf("to snorm8: %v", encoded)
+	}
+	actual := Vec4FromSnorm8(Vec4Create(), []int8{-128, -127, 0, 127})
+	if !testSlice(actual, []float64{-1, -1, 0, 1}) {
+		t.Errorf("from snorm8: %v", actual)
+	}
+}
+
+func TestVec2Unorm8(t *testing.T) {
+	encoded := Vec2ToUnorm8(make([]uint8, 2), []float64{-0.5, 0.5})
+	if encoded[0] != 0 || encoded[1] != 128 {
+		t.Errorf("to unorm8: %v", encoded)
+	}
+	actual := Vec2FromUnorm8(Vec2Create(), []uint8{0, 255})
+	if !testSlice(actual, []float64{0, 1}) {
+		t.Errorf("from unorm8: %v", actual)
+	}
+}
+
+func TestNormalizedError(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	s8 := make([]int8, 3)
+	u8 := make([]uint8, 3)
+	s16 := make([]int16, 3)
+	u16 := make([]uint16, 3)
+	v := Vec3Create()
+	for i := 0; i < 1000; i++ {
+		a := []float64{r.Float64()*2 - 1, r.Float64()*2 - 1, r.Float64()*2 - 1}
+		u := []float64{r.Float64(), r.Float64(), r.Float64()}
+		for j := 0; j < 3; j++ {
+			if d := math.Abs(Vec3FromSnorm8(v, Vec3ToSnorm8(s8, a))[j] - a[j]); d > 1./254+1e-12 {
+				t.Errorf("snorm8 error: %v", d)
+			}
+			if d := math.Abs(Vec3FromUnorm8(v, Vec3ToUnorm8(u8, u))[j] - u[j]); d > 1./510+1e-12 {
+				t.Errorf("unorm8 error: %v", d)
+			}
+			if d := math.Abs(Vec3FromSnorm16(v, Vec3ToSnorm16(s16, a))[j] - a[j]); d > 1./65534+1e-12 {
+				t.Errorf("snorm16 error: %v", d)
+			}
+			if d := math.Abs(Vec3FromUnorm16(v, Vec3ToUnorm16(u16, u))[j] - u[j]); d > 1./131070+1e-12 {
+				t.Errorf("unorm16 error: %v", d)
+			}
+		}
+	}
+}
+
+func TestVec4Unorm1010102(t *testing.T) {
+	v := Vec4ToUnorm1010102([]float64{1, 0, 0.5, 1})
+	if v != 0x3ff|512<<20|3<<30 {
+		t.Errorf("to unorm 1010102: %#08x", v)
+	}
+	actual := Vec4FromUnorm1010102(Vec4Create(), Vec4ToUnorm1010102([]float64{0.25, 0.75, 0.1, 2. / 3}))
+	if math.Abs(actual[0]-0.25) > 1./2046 || math.Abs(actual[1]-0.75) > 1./2046 ||
+		math.Abs(actual[2]-0.1) > 1./2046 || !equals(actual[3], 2./3) {
+		t.Errorf("unorm 1010102: %v", actual)
+	}
+}
+
+func TestVec4Snorm1010102(t *testing.T) {
+	v := Vec4ToSnorm1010102([]float64{-1, 1, 0, -1})
+	if v != 0x201|0x1ff<<10|3<<30 {
+		t.Errorf("to snorm 1010102: %#08x", v)
+	}
+	actual := Vec4FromSnorm1010102(Vec4Create(), v)
+	if !testSlice(actual, []float64{-1, 1, 0, -1}) {
+		t.Errorf("from snorm 1010102: %v", actual)
+	}
+	actual = Vec4FromSnorm1010102(Vec4Create(), Vec4ToSnorm1010102([]float64{-0.3, 0.7, -0.001, 1}))
+	if math.Abs(actual[0]+0.3) > 1./1022 || math.Abs(actual[1]-0.7) > 1./1022 ||
+		math.Abs(actual[2]+0.001) > 1./1022 || actual[3] != 1 {
+		t.Errorf("snorm 1010102: %v", actual)
+	}
+}
+
+func TestVec3Octahedral(t *testing.T) {
+	cases := [][]float64{
+		{0, 0, 1}, {0, 0, -1}, {1, 0, 0}, {0, -1, 0},
+		Vec3Normalize(Vec3Create(), []float64{1, 2, -3}),
+		Vec3Normalize(Vec3Create(), []float64{-1, -1, -1}),
+	}
+	oct := Vec2Create()
+	for _, a := range cases {
+		Vec3ToOctahedral(oct, a)
+		if math.Abs(oct[0]) > 1 || math.Abs(oct[1]) > 1 {
+			t.Errorf("octahedral range: %v", oct)
+		}
+		actual := Vec3FromOctahedral(Vec3Create(), oct)
+		if !testSlice(actual, a) {
+			t.Errorf("octahedral %v: %v", a, actual)
+		}
+	}
+}
+
+func TestVec3OctahedralError(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	oct := Vec2Create()
+	s8 := make([]int8, 2)
+	s16 := make([]int16, 2)
+	v := Vec3Create()
+	for i := 0; i < 10000; i++ {
+		a := Vec3Normalize(Vec3Create(), []float64{r.NormFloat64(), r.NormFloat64(), r.NormFloat64()})
+		Vec3ToOctahedral(oct, a)
+		Vec3FromOctahedral(v, Vec2FromSnorm16(Vec2Create(), Vec2ToSnorm16(s16, oct)))
+		if d := Vec3Angle(v, a); d > 0.0001 {
+			t.Errorf("octahedral snorm16 error: %v", d)
+		}
+		Vec3FromOctahedral(v, Vec2FromSnorm8(Vec2Create(), Vec2ToSnorm8(s8, oct)))
+		if d := Vec3Angle(v, a); d > 0.02 {
+			t.Errorf("octahedral snorm8 error: %v", d)
+		}
+	}
+}
+
+func TestQuatSmallestThree(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	actual := QuatFromSmallestThree(QuatCreate(), QuatToSmallestThree(QuatCreate()))
+	if !testSlice(actual, QuatCreate()) {
+		t.Errorf("smallest three identity: %v", actual)
+	}
+	for i := 0; i < 10000; i++ {
+		q := QuatRandom(QuatCreate())
+		if i%2 == 0 {
+			q[r.Intn(4)] *= -1
+		}
+		actual = QuatFromSmallestThree(actual, QuatToSmallestThree(q))
+		if d := QuatGetAngle(actual, q); d > 0.005 {
+			t.Errorf("smallest three error: %v %v", q, d)
+		}
+	}
+}
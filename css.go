@@ -0,0 +1,295 @@
+package glmatrix
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// cssValue is an argument of a CSS transform function
+type cssValue struct {
+	value   float64
+	unit    string
+	keyword string
+}
+
+// cssLengths are the absolute CSS length units in pixels
+var cssLengths = map[string]float64{
+	"px": 1,
+	"in": 96,
+	"cm": 96 / 2.54,
+	"mm": 96 / 25.4,
+	"q":  96 / 101.6,
+	"pt": 4. / 3,
+	"pc": 16,
+}
+
+// cssAngles are the CSS angle units in radians
+var cssAngles = map[string]float64{
+	"deg":  degree,
+	"rad":  1,
+	"grad": math.Pi / 200,
+	"turn": 2 * math.Pi,
+}
+
+// Mat4FromCSS set a mat4 to the transformation of a CSS transform property,
+// e.g. "translate3d(10px, 2px, 0) rotate3d(0, 1, 0, 0.25turn)". All the 2D and
+// 3D transform functions are supported and applied in order. Lengths must have
+// an absolute unit, which is converted into pixels, and angles may use deg,
+// rad, grad and turn. Unitless zero is accepted as both. An empty string or
+// "none" is the identity. It returns nil and an error describing the problem
+// for a malformed property, for lengths relative to the element or the font
+// and for a negative perspective.
+func Mat4FromCSS(out []float64, s string) ([]float64, error) {
+	m := Mat4Create()
+	p := &transformParser{kind: "CSS", s: s}
+	p.skipSpaces()
+	if strings.EqualFold(strings.TrimSpace(s), "none") {
+		p.pos = len(s)
+	}
+	for p.pos < len(s) {
+		name := p.name()
+		if name == "" {
+			return nil, p.errorf("expected a transform function")
+		}
+		args, err := p.cssArgs(name)
+		if err != nil {
+			return nil, err
+		}
+		if err := cssApply(m, name, args); err != nil {
+			return nil, p.errorf("%v", err)
+		}
+		p.skipSpaces()
+	}
+	return Mat4Copy(out, m), nil
+}
+
+// cssArgs parses a parenthesized list of arguments separated by commas
+func (p *transformParser) cssArgs(name string) ([]cssValue, error) {
+	if p.pos >= len(p.s) || p.s[p.pos] != '(' {
+		return nil, p.errorf("expected ( after %v", name)
+	}
+	p.pos++
+	var args []cssValue
+	for {
+		p.skipSpaces()
+		if p.pos < len(p.s) && p.s[p.pos] == ')' && len(args) == 0 {
+			p.pos++
+			return args, nil
+		}
+		var arg cssValue
+		if v, ok := p.number(); ok {
+			arg.value = v
+			start := p.pos
+			for p.pos < len(p.s) && (isLetter(p.s[p.pos]) || p.s[p.pos] == '%') {
+				p.pos++
+			}
+			arg.unit = strings.ToLower(p.s[start:p.pos])
+		} else if keyword := p.name(); keyword != "" {
+			arg.keyword = strings.ToLower(keyword)
+		} else {
+			return nil, p.errorf("expected a value")
+		}
+		args = append(args, arg)
+
+		p.skipSpaces()
+		if p.pos >= len(p.s) {
+			return nil, p.errorf("expected ) after the arguments of %v", name)
+		}
+		switch p.s[p.pos] {
+		case ',':
+			p.pos++
+		case ')':
+			p.pos++
+			return args, nil
+		default:
+			return nil, p.errorf("expected , or )")
+		}
+	}
+}
+
+func cssNumber(a cssValue) (float64, error) {
+	if a.keyword != "" || a.unit != "" {
+		return 0, fmt.Errorf("expected a number but got %v", a)
+	}
+	return a.value, nil
+}
+
+func cssScale(a cssValue) (float64, error) {
+	if a.keyword == "" && a.unit == "%" {
+		return a.value / 100, nil
+	}
+	return cssNumber(a)
+}
+
+func cssLength(a cssValue) (float64, error) {
+	if a.keyword == "" {
+		if a.unit == "" && a.value == 0 {
+			return 0, nil
+		}
+		if scale, ok := cssLengths[a.unit]; ok {
+			return a.value * scale, nil
+		}
+	}
+	return 0, fmt.Errorf("expected an absolute length but got %v", a)
+}
+
+func cssAngle(a cssValue) (float64, error) {
+	if a.keyword == "" {
+		if a.unit == "" && a.value == 0 {
+			return 0, nil
+		}
+		if scale, ok := cssAngles[a.unit]; ok {
+			return a.value * scale, nil
+		}
+	}
+	return 0, fmt.Errorf("expected an angle but got %v", a)
+}
+
+func (a cssValue) String() string {
+	if a.keyword != "" {
+		return a.keyword
+	}
+	return formatDecimal(a.value) + a.unit
+}
+
+// cssValues converts the arguments with the given parsers, the last of which
+// repeats for the remaining arguments
+func cssValues(args []cssValue, parsers ...func(cssValue) (float64, error)) ([]float64, error) {
+	values := make([]float64, len(args))
+	for i, a := range args {
+		parse := parsers[len(parsers)-1]
+		if i < len(parsers) {
+			parse = parsers[i]
+		}
+		v, err := parse(a)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return values, nil
+}
+
+func cssApply(m []float64, name string, args []cssValue) error {
+	n := len(args)
+	fn := strings.ToLower(name)
+	var counts []int
+	var parsers []func(cssValue) (float64, error)
+	switch fn {
+	case "matrix":
+		counts, parsers = []int{6}, []func(cssValue) (float64, error){cssNumber}
+	case "matrix3d":
+		counts, parsers = []int{16}, []func(cssValue) (float64, error){cssNumber}
+	case "translate":
+		counts, parsers = []int{1, 2}, []func(cssValue) (float64, error){cssLength}
+	case "translatex", "translatey", "translatez":
+		counts, parsers = []int{1}, []func(cssValue) (float64, error){cssLength}
+	case "translate3d":
+		counts, parsers = []int{3}, []func(cssValue) (float64, error){cssLength}
+	case "scale":
+		counts, parsers = []int{1, 2}, []func(cssValue) (float64, error){cssScale}
+	case "scalex", "scaley", "scalez":
+		counts, parsers = []int{1}, []func(cssValue) (float64, error){cssScale}
+	case "scale3d":
+		counts, parsers = []int{3}, []func(cssValue) (float64, error){cssScale}
+	case "rotate", "rotatex", "rotatey", "rotatez", "skewx", "skewy":
+		counts, parsers = []int{1}, []func(cssValue) (float64, error){cssAngle}
+	case "rotate3d":
+		counts, parsers = []int{4}, []func(cssValue) (float64, error){cssNumber, cssNumber, cssNumber, cssAngle}
+	case "skew":
+		counts, parsers = []int{1, 2}, []func(cssValue) (float64, error){cssAngle}
+	case "perspective":
+		if n == 1 && args[0].keyword == "none" {
+			return nil
+		}
+		counts, parsers = []int{1}, []func(cssValue) (float64, error){cssLength}
+	default:
+		return fmt.Errorf("unknown transform function %q", name)
+	}
+	valid := false
+	for _, c := range counts {
+		valid = valid || c == n
+	}
+	if !valid {
+		return fmt.Errorf("wrong number of arguments %v for %v", n, name)
+	}
+	v, err := cssValues(args, parsers...)
+	if err != nil {
+		return fmt.Errorf("%v: %v", name, err)
+	}
+	if fn == "perspective" && v[0] < 0 {
+		return fmt.Errorf("%v: expected a non-negative length but got %v", name, args[0])
+	}
+
+	t := Mat4Create()
+	switch fn {
+	case "matrix":
+		Mat4Set(t,
+			v[0], v[1], 0, 0,
+			v[2], v[3], 0, 0,
+			0, 0, 1, 0,
+			v[4], v[5], 0, 1)
+	case "matrix3d":
+		Mat4Copy(t, v)
+	case "translate":
+		if n == 1 {
+			v = append(v, 0)
+		}
+		Mat4FromTranslation(t, []float64{v[0], v[1], 0})
+	case "translatex":
+		Mat4FromTranslation(t, []float64{v[0], 0, 0})
+	case "translatey":
+		Mat4FromTranslation(t, []float64{0, v[0], 0})
+	case "translatez":
+		Mat4FromTranslation(t, []float64{0, 0, v[0]})
+	case "translate3d":
+		Mat4FromTranslation(t, v)
+	case "scale":
+		if n == 1 {
+			v = append(v, v[0])
+		}
+		Mat4FromScaling(t, []float64{v[0], v[1], 1})
+	case "scalex":
+		Mat4FromScaling(t, []float64{v[0], 1, 1})
+	case "scaley":
+		Mat4FromScaling(t, []float64{1, v[0], 1})
+	case "scalez":
+		Mat4FromScaling(t, []float64{1, 1, v[0]})
+	case "scale3d":
+		Mat4FromScaling(t, v)
+	case "rotate", "rotatez":
+		Mat4FromZRotation(t, v[0])
+	case "rotatex":
+		Mat4FromXRotation(t, v[0])
+	case "rotatey":
+		Mat4FromYRotation(t, v[0])
+	case "rotate3d":
+		// a zero axis is the identity
+		Mat4Rotate(t, t, v[3], v[0:3])
+	case "skew":
+		if n == 1 {
+			v = append(v, 0)
+		}
+		t[1] = math.Tan(v[1])
+		t[4] = math.Tan(v[0])
+	case "skewx":
+		t[4] = math.Tan(v[0])
+	case "skewy":
+		t[1] = math.Tan(v[0])
+	case "perspective":
+		// a distance in [0, 1px) is clamped to 1px
+		t[11] = -1 / math.Max(v[0], 1)
+	}
+	Mat4Multiply(m, m, t)
+	return nil
+}
+
+// Mat4StrCSS returns a CSS matrix3d transform function of a mat4
+func Mat4StrCSS(a []float64) string {
+	values := make([]string, 16)
+	for i := range values {
+		values[i] = formatDecimal(a[i])
+	}
+	return "matrix3d(" + strings.Join(values, ", ") + ")"
+}
@@ -0,0 +1,111 @@
+package glmatrix
+
+import (
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestMat4FromCSS(t *testing.T) {
+	expect := Mat4FromTranslation(Mat4Create(), []float64{10, 96, 0})
+	Mat4RotateY(expect, expect, math.Pi/2)
+	Mat4Scale(expect, expect, []float64{2, 0.5, 1})
+	Mat4RotateX(expect, expect, math.Pi/4)
+	actual, err := Mat4FromCSS(Mat4Create(), "translate3d(10px, 1in, 0) rotate3d(0, 1, 0, 0.25turn)\n\tscale(200%, .5) rotateX(45deg)")
+	if err != nil || !testSlice(actual, expect) {
+		t.Errorf("from css: %v %v", actual, err)
+	}
+}
+
+func TestMat4FromCSSFunctions(t *testing.T) {
+	skew := Mat4Create()
+	skew[1] = math.Tan(math.Pi / 4)
+	skew[4] = math.Tan(-math.Pi / 4)
+	perspective := Mat4Create()
+	perspective[11] = -0.01
+	cases := map[string][]float64{
+		"":                         Mat4Create(),
+		" NONE ":                   Mat4Create(),
+		"matrix(1, 2, 3, 4, 5, 6)": {1, 2, 0, 0, 3, 4, 0, 0, 0, 0, 1, 0, 5, 6, 0, 1},
+		"matrix3d(1,2,3,4,5,6,7,8,9,10,11,12,13,14,15,16)": {1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+		"translate(5px)":             Mat4FromTranslation(Mat4Create(), []float64{5, 0, 0}),
+		"translateX(1cm)":            Mat4FromTranslation(Mat4Create(), []float64{96 / 2.54, 0, 0}),
+		"translateY(12pt)":           Mat4FromTranslation(Mat4Create(), []float64{0, 16, 0}),
+		"translateZ(-1pc)":           Mat4FromTranslation(Mat4Create(), []float64{0, 0, -16}),
+		"TranslateZ(4Q)":             Mat4FromTranslation(Mat4Create(), []float64{0, 0, 96 / 25.4}),
+		"scale(3)":                   Mat4FromScaling(Mat4Create(), []float64{3, 3, 1}),
+		"scaleX(2) scaleY(3)":        Mat4FromScaling(Mat4Create(), []float64{2, 3, 1}),
+		"scaleZ(50%)":                Mat4FromScaling(Mat4Create(), []float64{1, 1, 0.5}),
+		"scale3d(1, 2, 3)":           Mat4FromScaling(Mat4Create(), []float64{1, 2, 3}),
+		"rotate(200grad)":            Mat4FromZRotation(Mat4Create(), math.Pi),
+		"rotateZ(1rad)":              Mat4FromZRotation(Mat4Create(), 1),
+		"rotateY(-30DEG)":            Mat4FromYRotation(Mat4Create(), ToRadian(-30)),
+		"rotate3d(0, 0, 0, 45deg)":   Mat4Create(),
+		"skew(-45deg, 45deg)":        skew,
+		"skewX(-45deg) skewY(45deg)": Mat4Multiply(Mat4Create(), []float64{1, 0, 0, 0, -1, 1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1}, []float64{1, 1, 0, 0, 0, 1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1}),
+		"skew(0)":                    Mat4Create(),
+		"perspective(100px)":         perspective,
+		"perspective(0)":             Mat4Set(Mat4Create(), 1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1, -1, 0, 0, 0, 1),
+		"perspective(none)":          Mat4Create(),
+	}
+	for s, expect := range cases {
+		actual, err := Mat4FromCSS(Mat4Create(), s)
+		if err != nil || !testSlice(actual, expect) {
+			t.Errorf("from css %q: %v %v", s, actual, err)
+		}
+	}
+}
+
+func TestMat4FromCSSErrors(t *testing.T) {
+	cases := map[string]string{
+		"translate(1px, 2px, 3px)": "wrong number of arguments 3 for translate",
+		"rotate3d(1, 0, 0)":        "wrong number of arguments 3 for rotate3d",
+		"spin(45deg)":              `unknown transform function "spin"`,
+		"translate(1em)":           "translate: expected an absolute length but got 1em",
+		"translateX(50%)":          "translateX: expected an absolute length but got 50%",
+		"translateX(5)":            "expected an absolute length but got 5",
+		"rotate(45)":               "rotate: expected an angle but got 45",
+		"rotate3d(1px, 0, 0, 0)":   "rotate3d: expected a number but got 1px",
+		"scale(none)":              "scale: expected a number but got none",
+		"scale 2":                  "expected ( after scale",
+		"scale(2":                  "expected ) after the arguments of scale",
+		"scale(2 3)":               "expected , or )",
+		"scale(,2)":                "expected a value",
+		"(1)":                      "expected a transform function",
+		"perspective(-10px)":       "perspective: expected a non-negative length but got -10px",
+	}
+	for s, expect := range cases {
+		actual, err := Mat4FromCSS(Mat4Create(), s)
+		if actual != nil || err == nil || !strings.Contains(err.Error(), expect) {
+			t.Errorf("from css %q: %v", s, err)
+		}
+	}
+}
+
+func TestMat4StrCSS(t *testing.T) {
+	actual := Mat4StrCSS(Mat4Create())
+	expect := "matrix3d(1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1)"
+	if actual != expect {
+		t.Errorf("str css: %v", actual)
+	}
+	actual = Mat4StrCSS(Mat4FromZRotation(Mat4Create(), math.Pi/2))
+	expect = "matrix3d(0, 1, 0, 0, -1, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1)"
+	if actual != expect {
+		t.Errorf("str css rotation: %v", actual)
+	}
+}
+
+func TestMat4CSSRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		m := Mat4Create()
+		for j := range m {
+			m[j] = r.Float64()*200 - 100
+		}
+		actual, err := Mat4FromCSS(Mat4Create(), Mat4StrCSS(m))
+		if err != nil || !Mat4Equals(actual, m) {
+			t.Errorf("css round trip %v: %v %v", Mat4StrCSS(m), actual, err)
+		}
+	}
+}
@@ -0,0 +1,268 @@
+package glmatrix
+
+import (
+	"fmt"
+	"math"
+)
+
+// Mat4FromDH creates a link transformation matrix from standard
+// Denavit-Hartenberg parameters.
+// This is equivalent to (but much faster than):
+//
+// - Mat4Identity(dest)
+// - Mat4RotateZ(dest, dest, theta)
+// - Mat4Translate(dest, dest, [a, 0, d])
+// - Mat4RotateX(dest, dest, alpha)
+func Mat4FromDH(out []float64, a, alpha, d, theta float64) []float64 {
+	ct := math.Cos(theta)
+	st := math.Sin(theta)
+	ca := math.Cos(alpha)
+	sa := math.Sin(alpha)
+	out[0] = ct
+	out[1] = st
+	out[2] = 0
+	out[3] = 0
+	out[4] = -st * ca
+	out[5] = ct * ca
+	out[6] = sa
+	out[7] = 0
+	out[8] = st * sa
+	out[9] = -ct * sa
+	out[10] = ca
+	out[11] = 0
+	out[12] = a * ct
+	out[13] = a * st
+	out[14] = d
+	out[15] = 1
+	return out
+}
+
+// Mat4FromModifiedDH creates a link transformation matrix from modified
+// (Craig's) Denavit-Hartenberg parameters.
+// This is equivalent to (but much faster than):
+//
+// - Mat4Identity(dest)
+// - Mat4RotateX(dest, dest, alpha)
+// - Mat4Translate(dest, dest, [a, 0, 0])
+// - Mat4RotateZ(dest, dest, theta)
+// - Mat4Translate(dest, dest, [0, 0, d])
+func Mat4FromModifiedDH(out []float64, a, alpha, d, theta float64) []float64 {
+	ct := math.Cos(theta)
+	st := math.Sin(theta)
+	ca := math.Cos(alpha)
+	sa := math.Sin(alpha)
+	out[0] = ct
+	out[1] = st * ca
+	out[2] = st * sa
+	out[3] = 0
+	out[4] = -st
+	out[5] = ct * ca
+	out[6] = ct * sa
+	out[7] = 0
+	out[8] = 0
+	out[9] = -sa
+	out[10] = ca
+	out[11] = 0
+	out[12] = a
+	out[13] = -sa * d
+	out[14] = ca * d
+	out[15] = 1
+	return out
+}
+
+// DHLink represent the Denavit-Hartenberg parameters of a link.
+// The joint variable is added to Theta for a revolute joint
+// and to D for a prismatic joint.
+type DHLink struct {
+	A         float64
+	Alpha     float64
+	D         float64
+	Theta     float64
+	Prismatic bool
+}
+
+// DHChain represent a serial chain of links
+type DHChain struct {
+	Links []DHLink
+
+	// Modified selects the modified (Craig's) convention
+	Modified bool
+
+	// Base is an optional mat4 placed before the first link
+	Base []float64
+
+	// Tool is an optional mat4 placed after the last link
+	Tool []float64
+}
+
+// Frames calculates the world transformation matrices of the base frame,
+// of every link frame and of the tool frame for the given joint variables.
+// The matrices are written one after another into out, which must hold
+// (len(c.Links) + 2) * 16 values.
+func (c *DHChain) Frames(out, q []float64) []float64 {
+	n := len(c.Links)
+	if len(q) != n {
+		panic(fmt.Sprintf("Expected %v joint variables but got %v", n, len(q)))
+	}
+	if c.Base != nil {
+		Mat4Copy(out[0:16], c.Base)
+	} else {
+		Mat4Identity(out[0:16])
+	}
+	link := Mat4Create()
+	for i, l := range c.Links {
+		theta := l.Theta
+		d := l.D
+		if l.Prismatic {
+			d += q[i]
+		} else {
+			theta += q[i]
+		}
+		if c.Modified {
+			Mat4FromModifiedDH(link, l.A, l.Alpha, d, theta)
+		} else {
+			Mat4FromDH(link, l.A, l.Alpha, d, theta)
+		}
+		Mat4Multiply(out[(i+1)*16:(i+2)*16], out[i*16:(i+1)*16], link)
+	}
+	if c.Tool != nil {
+		Mat4Multiply(out[(n+1)*16:(n+2)*16], out[n*16:(n+1)*16], c.Tool)
+	} else {
+		Mat4Copy(out[(n+1)*16:(n+2)*16], out[n*16:(n+1)*16])
+	}
+	return out
+}
+
+// ForwardKinematics calculates the transformation matrix of the tool frame
+// for the given joint variables
+func (c *DHChain) ForwardKinematics(out, q []float64) []float64 {
+	n := len(c.Links)
+	frames := c.Frames(make([]float64, (n+2)*16), q)
+	return Mat4Copy(out, frames[(n+1)*16:])
+}
+
+// Jacobian calculates the 6 x n geometric jacobian of the tool frame for the
+// given joint variables. Each column maps the velocity of a joint to the
+// linear and angular velocity [vx, vy, vz, wx, wy, wz] of the tool in world
+// space, and the matrix is stored in column-major order.
+func (c *DHChain) Jacobian(out, q []float64) []float64 {
+	n := len(c.Links)
+	frames := c.Frames(make([]float64, (n+2)*16), q)
+	end := frames[(n+1)*16+12 : (n+1)*16+15]
+	r := Vec3Create()
+	for i, l := range c.Links {
+		// the joint moves along the z axis of the frame before the link in
+		// the standard convention and of the frame of the link in the modified one
+		f := frames[i*16 : (i+1)*16]
+		if c.Modified {
+			f = frames[(i+1)*16 : (i+2)*16]
+		}
+		z := f[8:11]
+		col := out[i*6 : (i+1)*6]
+		if l.Prismatic {
+			Vec3Copy(col[0:3], z)
+			Vec3Zero(col[3:6])
+		} else {
+			Vec3Subtract(r, end, f[12:15])
+			Vec3Cross(col[0:3], z, r)
+			Vec3Copy(col[3:6], z)
+		}
+	}
+	return out
+}
+
+// DLSOptions represent the settings of DHChain.InverseKinematics
+type DLSOptions struct {
+	// MaxIterations is the maximum number of iterations. Defaults to 100.
+	MaxIterations int
+
+	// Tolerance stops the iteration when the error is below this value.
+	// Defaults to 1e-6.
+	Tolerance float64
+
+	// Damping is the damping factor of the least squares. Defaults to 0.1.
+	Damping float64
+
+	// PositionOnly ignores the orientation of the target
+	PositionOnly bool
+}
+
+// InverseKinematics moves the joint variables q in place by damped least
+// squares so that the tool frame reaches the target mat4. opts may be nil
+// to use the defaults. It returns the remaining error, the length of the
+// position error plus the angle of the orientation error.
+func (c *DHChain) InverseKinematics(q, target []float64, opts *DLSOptions) float64 {
+	if opts == nil {
+		opts = &DLSOptions{}
+	}
+	maxIterations := opts.MaxIterations
+	if maxIterations <= 0 {
+		maxIterations = 100
+	}
+	tolerance := opts.Tolerance
+	if tolerance <= 0 {
+		tolerance = 1e-6
+	}
+	damping := opts.Damping
+	if damping <= 0 {
+		damping = 0.1
+	}
+	rows := 6
+	if opts.PositionOnly {
+		rows = 3
+	}
+
+	n := len(c.Links)
+	current := Mat4Create()
+	jacobian := make([]float64, 6*n)
+	e := make([]float64, 6)
+	jjt := make([]float64, rows*rows)
+	err := c.dlsError(e, current, q, target)
+	if opts.PositionOnly {
+		err = Vec3Length(e[0:3])
+	}
+	for iter := 0; iter < maxIterations && err > tolerance; iter++ {
+		c.Jacobian(jacobian, q)
+		// (J J^T + damping^2 I) y = e, dq = J^T y
+		for r := 0; r < rows; r++ {
+			for k := 0; k < rows; k++ {
+				sum := 0.
+				for i := 0; i < n; i++ {
+					sum += jacobian[i*6+r] * jacobian[i*6+k]
+				}
+				if r == k {
+					sum += damping * damping
+				}
+				jjt[k*rows+r] = sum
+			}
+		}
+		y := solveLinear(jjt, e[0:rows], rows)
+		if y == nil {
+			break
+		}
+		for i := 0; i < n; i++ {
+			for r := 0; r < rows; r++ {
+				q[i] += jacobian[i*6+r] * y[r]
+			}
+		}
+		err = c.dlsError(e, current, q, target)
+		if opts.PositionOnly {
+			err = Vec3Length(e[0:3])
+		}
+	}
+	return err
+}
+
+// dlsError sets e to the position error and the rotation vector of the
+// orientation error of the tool frame and returns the size of the error
+func (c *DHChain) dlsError(e, current, q, target []float64) float64 {
+	c.ForwardKinematics(current, q)
+	e[0] = target[12] - current[12]
+	e[1] = target[13] - current[13]
+	e[2] = target[14] - current[14]
+	rt := Mat3FromMat4(Mat3Create(), target)
+	rc := Mat3FromMat4(Mat3Create(), current)
+	Mat3Multiply(rt, rt, Mat3Transpose(rc, rc))
+	SO3Log(e[3:6], rt)
+	return Vec3Length(e[0:3]) + Vec3Length(e[3:6])
+}
@@ -0,0 +1,120 @@
+package glmatrix
+
+import (
+	"math"
+	"testing"
+)
+
+var ur5 = &DHChain{
+	Links: []DHLink{
+		{D: 0.089159, Alpha: math.Pi / 2},
+		{A: -0.425},
+		{A: -0.39225},
+		{D: 0.10915, Alpha: math.Pi / 2},
+		{D: 0.09465, Alpha: -math.Pi / 2},
+		{D: 0.0823},
+	},
+}
+
+var modifiedChain = &DHChain{
+	Modified: true,
+	Base:     Mat4FromTranslation(Mat4Create(), []float64{0, 0, 0.5}),
+	Tool:     Mat4FromTranslation(Mat4Create(), []float64{0, 0, 0.1}),
+	Links: []DHLink{
+		{},
+		{Alpha: -math.Pi / 2, Theta: 0.2},
+		{A: 0.4, Prismatic: true, D: 0.1},
+		{A: 0.1, Alpha: math.Pi / 2, D: 0.3},
+	},
+}
+
+func TestMat4FromDH(t *testing.T) {
+	actual := Mat4FromDH(Mat4Create(), 0.5, 0.3, 0.2, 1.1)
+	expect := Mat4RotateZ(Mat4Create(), Mat4Create(), 1.1)
+	Mat4Translate(expect, expect, []float64{0.5, 0, 0.2})
+	Mat4RotateX(expect, expect, 0.3)
+	if !testSlice(actual, expect) {
+		t.Errorf("from dh: %v", actual)
+	}
+}
+
+func TestMat4FromModifiedDH(t *testing.T) {
+	actual := Mat4FromModifiedDH(Mat4Create(), 0.5, 0.3, 0.2, 1.1)
+	expect := Mat4RotateX(Mat4Create(), Mat4Create(), 0.3)
+	Mat4Translate(expect, expect, []float64{0.5, 0, 0})
+	Mat4RotateZ(expect, expect, 1.1)
+	Mat4Translate(expect, expect, []float64{0, 0, 0.2})
+	if !testSlice(actual, expect) {
+		t.Errorf("from modified dh: %v", actual)
+	}
+}
+
+func TestDHChainForwardKinematics(t *testing.T) {
+	planar := &DHChain{Links: []DHLink{{A: 1}, {A: 1}}}
+	actual := planar.ForwardKinematics(Mat4Create(), []float64{math.Pi / 2, -math.Pi / 2})
+	expect := Mat4FromTranslation(Mat4Create(), []float64{1, 1, 0})
+	if !testSlice(actual, expect) {
+		t.Errorf("forward kinematics: %v", actual)
+	}
+
+	frames := planar.Frames(make([]float64, 64), []float64{math.Pi / 2, -math.Pi / 2})
+	if !testSlice(frames[16+12:16+15], []float64{0, 1, 0}) {
+		t.Errorf("frames: %v", frames)
+	}
+
+	// all joints at zero stretch the UR5 along -x
+	actual = ur5.ForwardKinematics(Mat4Create(), make([]float64, 6))
+	if !testSlice(actual[12:15], []float64{-0.81725, -0.19145, -0.005491}) {
+		t.Errorf("ur5 forward kinematics: %v", actual[12:15])
+	}
+}
+
+func testDHJacobian(t *testing.T, name string, c *DHChain, q []float64) {
+	n := len(q)
+	jacobian := c.Jacobian(make([]float64, 6*n), q)
+	m := c.ForwardKinematics(Mat4Create(), q)
+	rinv := Mat3Transpose(Mat3Create(), Mat3FromMat4(Mat3Create(), m))
+	h := 1e-6
+	for i := 0; i < n; i++ {
+		qh := append([]float64{}, q...)
+		qh[i] += h
+		mh := c.ForwardKinematics(Mat4Create(), qh)
+		actual := make([]float64, 6)
+		for k := 0; k < 3; k++ {
+			actual[k] = (mh[12+k] - m[12+k]) / h
+		}
+		r := Mat3Multiply(Mat3Create(), Mat3FromMat4(Mat3Create(), mh), rinv)
+		SO3Log(actual[3:6], r)
+		Vec3Scale(actual[3:6], actual[3:6], 1/h)
+		if !testSliceTol(actual, jacobian[i*6:i*6+6], 1e-5) {
+			t.Errorf("%v jacobian column %v: %v %v", name, i, actual, jacobian[i*6:i*6+6])
+		}
+	}
+}
+
+func TestDHChainJacobian(t *testing.T) {
+	testDHJacobian(t, "ur5", ur5, []float64{0.1, -0.5, 0.8, 0.3, -0.2, 0.6})
+	testDHJacobian(t, "modified", modifiedChain, []float64{0.3, -0.4, 0.2, 0.7})
+}
+
+func TestDHChainInverseKinematics(t *testing.T) {
+	goal := []float64{0.5, -1, 1.2, -0.3, 0.8, 0.2}
+	target := ur5.ForwardKinematics(Mat4Create(), goal)
+	q := []float64{0.3, -0.8, 1, 0, 0.5, 0}
+	err := ur5.InverseKinematics(q, target, nil)
+	if err > 1e-6 {
+		t.Errorf("inverse kinematics: %v", err)
+	}
+	actual := ur5.ForwardKinematics(Mat4Create(), q)
+	if !testSliceTol(actual, target, 1e-6) {
+		t.Errorf("inverse kinematics pose: %v", actual)
+	}
+
+	target = Mat4FromTranslation(Mat4Create(), []float64{0.3, 0.2, 0.4})
+	q = []float64{0.3, -0.8, 1, 0, 0.5, 0}
+	err = ur5.InverseKinematics(q, target, &DLSOptions{PositionOnly: true})
+	actual = ur5.ForwardKinematics(Mat4Create(), q)
+	if err > 1e-6 || !testSliceTol(actual[12:15], target[12:15], 1e-6) {
+		t.Errorf("inverse kinematics position only: %v %v", err, actual[12:15])
+	}
+}
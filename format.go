@@ -0,0 +1,419 @@
+package glmatrix
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+)
+
+// matrixShape describes how the elements of a matrix are laid out in rows
+type matrixShape struct {
+	rows     int
+	cols     int
+	rowMajor bool
+}
+
+func (s matrixShape) at(a []float64, r, c int) float64 {
+	if s.rowMajor {
+		return a[r*s.cols+c]
+	}
+	return a[c*s.rows+r]
+}
+
+// cells formats the elements of a matrix row by row
+func (s matrixShape) cells(a []float64, format func(float64) string) [][]string {
+	rows := make([][]string, s.rows)
+	for r := range rows {
+		rows[r] = make([]string, s.cols)
+		for c := range rows[r] {
+			rows[r][c] = format(s.at(a, r, c))
+		}
+	}
+	return rows
+}
+
+// aligned joins the cells right aligned in their columns
+func (s matrixShape) aligned(cells [][]string, indent string) string {
+	widths := make([]int, s.cols)
+	for _, row := range cells {
+		for c, cell := range row {
+			if len(cell) > widths[c] {
+				widths[c] = len(cell)
+			}
+		}
+	}
+	lines := make([]string, len(cells))
+	for r, row := range cells {
+		padded := make([]string, len(row))
+		for c, cell := range row {
+			padded[c] = strings.Repeat(" ", widths[c]-len(cell)) + cell
+		}
+		lines[r] = indent + strings.Join(padded, "  ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func formatPrecision(precision int) func(float64) string {
+	return func(v float64) string {
+		if precision < 0 {
+			return strconv.FormatFloat(v, 'g', -1, 64)
+		}
+		return strconv.FormatFloat(v, 'f', precision, 64)
+	}
+}
+
+func (s matrixShape) strRows(a []float64, precision int) string {
+	return s.aligned(s.cells(a, formatPrecision(precision)), "")
+}
+
+func (s matrixShape) strLatex(a []float64, precision int) string {
+	lines := []string{`\begin{bmatrix}`}
+	for r, row := range s.cells(a, formatPrecision(precision)) {
+		line := strings.Join(row, " & ")
+		if r < s.rows-1 {
+			line += ` \\`
+		}
+		lines = append(lines, line)
+	}
+	lines = append(lines, `\end{bmatrix}`)
+	return strings.Join(lines, "\n")
+}
+
+func (s matrixShape) strMarkdown(a []float64, precision int) string {
+	header := strings.Repeat("|   ", s.cols) + "|"
+	separator := strings.Repeat("|--:", s.cols) + "|"
+	lines := []string{header, separator}
+	for _, row := range s.cells(a, formatPrecision(precision)) {
+		lines = append(lines, "| "+strings.Join(row, " | ")+" |")
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatValue implements fmt.Formatter for the named types
+func formatValue(f fmt.State, verb rune, a []float64, name string, fields []string, shape *matrixShape) {
+	switch verb {
+	case 'v', 's', 'f', 'F', 'e', 'E', 'g', 'G':
+	default:
+		fmt.Fprintf(f, "%%!%c(%s=%v)", verb, name, []float64(a))
+		return
+	}
+	if len(a) != len(fields) {
+		fmt.Fprintf(f, "%%!%c(BADLEN %s=%v)", verb, name, []float64(a))
+		return
+	}
+
+	// rebuild the directive for each element, where %v of a float64 is %g
+	verbose := verb == 'v' && f.Flag('+')
+	spec := "%"
+	for _, flag := range "+- #0" {
+		if f.Flag(int(flag)) && !(flag == '+' && verbose) {
+			spec += string(flag)
+		}
+	}
+	if width, ok := f.Width(); ok {
+		spec += strconv.Itoa(width)
+	}
+	if precision, ok := f.Precision(); ok {
+		spec += "." + strconv.Itoa(precision)
+	}
+	if verb == 'v' || verb == 's' {
+		verb = 'g'
+	}
+	spec += string(verb)
+	format := func(v float64) string {
+		return fmt.Sprintf(spec, v)
+	}
+
+	switch {
+	case verbose && shape != nil:
+		fmt.Fprintf(f, "%s(\n%s\n)", name, shape.aligned(shape.cells(a, format), "  "))
+	case verbose:
+		values := make([]string, len(a))
+		for i, v := range a {
+			values[i] = fields[i] + ": " + format(v)
+		}
+		fmt.Fprintf(f, "%s(%s)", name, strings.Join(values, ", "))
+	default:
+		values := make([]string, len(a))
+		for i, v := range a {
+			values[i] = format(v)
+		}
+		fmt.Fprintf(f, "%s(%s)", name, strings.Join(values, ", "))
+	}
+}
+
+// logValue implements slog.LogValuer for the named types. Vectors are logged
+// as groups of their components and matrices as arrays of rows.
+func logValue(a []float64, fields []string, shape *matrixShape) slog.Value {
+	if len(a) != len(fields) {
+		return slog.AnyValue([]float64(a))
+	}
+	if shape != nil {
+		rows := make([][]float64, shape.rows)
+		for r := range rows {
+			rows[r] = make([]float64, shape.cols)
+			for c := range rows[r] {
+				rows[r][c] = shape.at(a, r, c)
+			}
+		}
+		return slog.AnyValue(rows)
+	}
+	attrs := make([]slog.Attr, len(a))
+	for i, v := range a {
+		attrs[i] = slog.Float64(fields[i], v)
+	}
+	return slog.GroupValue(attrs...)
+}
+
+var mat2Shape = matrixShape{2, 2, false}
+var mat2dShape = matrixShape{2, 3, false}
+var mat3Shape = matrixShape{3, 3, false}
+var mat4Shape = matrixShape{4, 4, false}
+var mat2x3Shape = matrixShape{2, 3, true}
+var mat3x4Shape = matrixShape{3, 4, true}
+var mat4x3Shape = matrixShape{3, 4, false}
+
+// Mat2StrRows returns the elements of a mat2 laid out in aligned rows.
+// A negative precision formats the elements with the fewest digits.
+func Mat2StrRows(a []float64, precision int) string {
+	return mat2Shape.strRows(a, precision)
+}
+
+// Mat2StrLatex returns a LaTeX bmatrix of a mat2
+func Mat2StrLatex(a []float64, precision int) string {
+	return mat2Shape.strLatex(a, precision)
+}
+
+// Mat2StrMarkdown returns a Markdown table of a mat2
+func Mat2StrMarkdown(a []float64, precision int) string {
+	return mat2Shape.strMarkdown(a, precision)
+}
+
+// Mat2dStrRows returns the elements of a mat2d laid out in aligned rows.
+// A negative precision formats the elements with the fewest digits.
+func Mat2dStrRows(a []float64, precision int) string {
+	return mat2dShape.strRows(a, precision)
+}
+
+// Mat2dStrLatex returns a LaTeX bmatrix of a mat2d
+func Mat2dStrLatex(a []float64, precision int) string {
+	return mat2dShape.strLatex(a, precision)
+}
+
+// Mat2dStrMarkdown returns a Markdown table of a mat2d
+func Mat2dStrMarkdown(a []float64, precision int) string {
+	return mat2dShape.strMarkdown(a, precision)
+}
+
+// Mat3StrRows returns the elements of a mat3 laid out in aligned rows.
+// A negative precision formats the elements with the fewest digits.
+func Mat3StrRows(a []float64, precision int) string {
+	return mat3Shape.strRows(a, precision)
+}
+
+// Mat3StrLatex returns a LaTeX bmatrix of a mat3
+func Mat3StrLatex(a []float64, precision int) string {
+	return mat3Shape.strLatex(a, precision)
+}
+
+// Mat3StrMarkdown returns a Markdown table of a mat3
+func Mat3StrMarkdown(a []float64, precision int) string {
+	return mat3Shape.strMarkdown(a, precision)
+}
+
+// Mat4StrRows returns the elements of a mat4 laid out in aligned rows.
+// A negative precision formats the elements with the fewest digits.
+func Mat4StrRows(a []float64, precision int) string {
+	return mat4Shape.strRows(a, precision)
+}
+
+// Mat4StrLatex returns a LaTeX bmatrix of a mat4
+func Mat4StrLatex(a []float64, precision int) string {
+	return mat4Shape.strLatex(a, precision)
+}
+
+// Mat4StrMarkdown returns a Markdown table of a mat4
+func Mat4StrMarkdown(a []float64, precision int) string {
+	return mat4Shape.strMarkdown(a, precision)
+}
+
+// Mat2x3StrRows returns the elements of a mat2x3 laid out in aligned rows.
+// A negative precision formats the elements with the fewest digits.
+func Mat2x3StrRows(a []float64, precision int) string {
+	return mat2x3Shape.strRows(a, precision)
+}
+
+// Mat2x3StrLatex returns a LaTeX bmatrix of a mat2x3
+func Mat2x3StrLatex(a []float64, precision int) string {
+	return mat2x3Shape.strLatex(a, precision)
+}
+
+// Mat2x3StrMarkdown returns a Markdown table of a mat2x3
+func Mat2x3StrMarkdown(a []float64, precision int) string {
+	return mat2x3Shape.strMarkdown(a, precision)
+}
+
+// Mat3x4StrRows returns the elements of a mat3x4 laid out in aligned rows.
+// A negative precision formats the elements with the fewest digits.
+func Mat3x4StrRows(a []float64, precision int) string {
+	return mat3x4Shape.strRows(a, precision)
+}
+
+// Mat3x4StrLatex returns a LaTeX bmatrix of a mat3x4
+func Mat3x4StrLatex(a []float64, precision int) string {
+	return mat3x4Shape.strLatex(a, precision)
+}
+
+// Mat3x4StrMarkdown returns a Markdown table of a mat3x4
+func Mat3x4StrMarkdown(a []float64, precision int) string {
+	return mat3x4Shape.strMarkdown(a, precision)
+}
+
+// Mat4x3StrRows returns the elements of a mat4x3 laid out in aligned rows.
+// A negative precision formats the elements with the fewest digits.
+func Mat4x3StrRows(a []float64, precision int) string {
+	return mat4x3Shape.strRows(a, precision)
+}
+
+// Mat4x3StrLatex returns a LaTeX bmatrix of a mat4x3
+func Mat4x3StrLatex(a []float64, precision int) string {
+	return mat4x3Shape.strLatex(a, precision)
+}
+
+// Mat4x3StrMarkdown returns a Markdown table of a mat4x3
+func Mat4x3StrMarkdown(a []float64, precision int) string {
+	return mat4x3Shape.strMarkdown(a, precision)
+}
+
+// Format implements fmt.Formatter. The verbs v, f, e and g format the
+// elements like float64 values, and %+v prints the vec2 with the names of the components.
+func (a Vec2) Format(f fmt.State, verb rune) {
+	formatValue(f, verb, a, "vec2", vec2Fields, nil)
+}
+
+// LogValue implements slog.LogValuer
+func (a Vec2) LogValue() slog.Value {
+	return logValue(a, vec2Fields, nil)
+}
+
+// Format implements fmt.Formatter. The verbs v, f, e and g format the
+// elements like float64 values, and %+v prints the vec3 with the names of the components.
+func (a Vec3) Format(f fmt.State, verb rune) {
+	formatValue(f, verb, a, "vec3", vec3Fields, nil)
+}
+
+// LogValue implements slog.LogValuer
+func (a Vec3) LogValue() slog.Value {
+	return logValue(a, vec3Fields, nil)
+}
+
+// Format implements fmt.Formatter. The verbs v, f, e and g format the
+// elements like float64 values, and %+v prints the vec4 with the names of the components.
+func (a Vec4) Format(f fmt.State, verb rune) {
+	formatValue(f, verb, a, "vec4", vec4Fields, nil)
+}
+
+// LogValue implements slog.LogValuer
+func (a Vec4) LogValue() slog.Value {
+	return logValue(a, vec4Fields, nil)
+}
+
+// Format implements fmt.Formatter. The verbs v, f, e and g format the
+// elements like float64 values, and %+v prints the mat2 laid out in rows.
+func (a Mat2) Format(f fmt.State, verb rune) {
+	formatValue(f, verb, a, "mat2", mat2Fields, &mat2Shape)
+}
+
+// LogValue implements slog.LogValuer
+func (a Mat2) LogValue() slog.Value {
+	return logValue(a, mat2Fields, &mat2Shape)
+}
+
+// Format implements fmt.Formatter. The verbs v, f, e and g format the
+// elements like float64 values, and %+v prints the mat2d laid out in rows.
+func (a Mat2d) Format(f fmt.State, verb rune) {
+	formatValue(f, verb, a, "mat2d", mat2dFields, &mat2dShape)
+}
+
+// LogValue implements slog.LogValuer
+func (a Mat2d) LogValue() slog.Value {
+	return logValue(a, mat2dFields, &mat2dShape)
+}
+
+// Format implements fmt.Formatter. The verbs v, f, e and g format the
+// elements like float64 values, and %+v prints the mat3 laid out in rows.
+func (a Mat3) Format(f fmt.State, verb rune) {
+	formatValue(f, verb, a, "mat3", mat3Fields, &mat3Shape)
+}
+
+// LogValue implements slog.LogValuer
+func (a Mat3) LogValue() slog.Value {
+	return logValue(a, mat3Fields, &mat3Shape)
+}
+
+// Format implements fmt.Formatter. The verbs v, f, e and g format the
+// elements like float64 values, and %+v prints the mat4 laid out in rows.
+func (a Mat4) Format(f fmt.State, verb rune) {
+	formatValue(f, verb, a, "mat4", mat4Fields, &mat4Shape)
+}
+
+// LogValue implements slog.LogValuer
+func (a Mat4) LogValue() slog.Value {
+	return logValue(a, mat4Fields, &mat4Shape)
+}
+
+// Format implements fmt.Formatter. The verbs v, f, e and g format the
+// elements like float64 values, and %+v prints the quat with the names of the components.
+func (a Quat) Format(f fmt.State, verb rune) {
+	formatValue(f, verb, a, "quat", quatFields, nil)
+}
+
+// LogValue implements slog.LogValuer
+func (a Quat) LogValue() slog.Value {
+	return logValue(a, quatFields, nil)
+}
+
+// Format implements fmt.Formatter. The verbs v, f, e and g format the
+// elements like float64 values, and %+v prints the quat2 with the names of the components.
+func (a Quat2) Format(f fmt.State, verb rune) {
+	formatValue(f, verb, a, "quat2", quat2Fields, nil)
+}
+
+// LogValue implements slog.LogValuer
+func (a Quat2) LogValue() slog.Value {
+	return logValue(a, quat2Fields, nil)
+}
+
+// Format implements fmt.Formatter. The verbs v, f, e and g format the
+// elements like float64 values, and %+v prints the mat2x3 laid out in rows.
+func (a Mat2x3) Format(f fmt.State, verb rune) {
+	formatValue(f, verb, a, "mat2x3", mat2x3Fields, &mat2x3Shape)
+}
+
+// LogValue implements slog.LogValuer
+func (a Mat2x3) LogValue() slog.Value {
+	return logValue(a, mat2x3Fields, &mat2x3Shape)
+}
+
+// Format implements fmt.Formatter. The verbs v, f, e and g format the
+// elements like float64 values, and %+v prints the mat3x4 laid out in rows.
+func (a Mat3x4) Format(f fmt.State, verb rune) {
+	formatValue(f, verb, a, "mat3x4", mat3x4Fields, &mat3x4Shape)
+}
+
+// LogValue implements slog.LogValuer
+func (a Mat3x4) LogValue() slog.Value {
+	return logValue(a, mat3x4Fields, &mat3x4Shape)
+}
+
+// Format implements fmt.Formatter. The verbs v, f, e and g format the
+// elements like float64 values, and %+v prints the mat4x3 laid out in rows.
+func (a Mat4x3) Format(f fmt.State, verb rune) {
+	formatValue(f, verb, a, "mat4x3", mat4x3Fields, &mat4x3Shape)
+}
+
+// LogValue implements slog.LogValuer
+func (a Mat4x3) LogValue() slog.Value {
+	return logValue(a, mat4x3Fields, &mat4x3Shape)
+}
@@ -0,0 +1,115 @@
+package glmatrix
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestFormatVector(t *testing.T) {
+	v := Vec3([]float64{1, 2.5, -3})
+	cases := map[string]string{
+		"%v":    "vec3(1, 2.5, -3)",
+		"%s":    "vec3(1, 2.5, -3)",
+		"%.3f":  "vec3(1.000, 2.500, -3.000)",
+		"%+.1f": "vec3(+1.0, +2.5, -3.0)",
+		"%+v":   "vec3(x: 1, y: 2.5, z: -3)",
+		"%5.1f": "vec3(  1.0,   2.5,  -3.0)",
+		"%e":    "vec3(1.000000e+00, 2.500000e+00, -3.000000e+00)",
+		"%d":    "%!d(vec3=[1 2.5 -3])",
+	}
+	for format, expect := range cases {
+		if actual := fmt.Sprintf(format, v); actual != expect {
+			t.Errorf("format %v: %v", format, actual)
+		}
+	}
+	if actual := fmt.Sprintf("%v", Vec3(vec4A)); actual != "%!v(BADLEN vec3=[1 2 3 4])" {
+		t.Errorf("format bad length: %v", actual)
+	}
+	if actual := fmt.Sprintf("%+.2f", Quat(QuatCreate())); actual != "quat(+0.00, +0.00, +0.00, +1.00)" {
+		t.Errorf("format quat: %v", actual)
+	}
+}
+
+func TestFormatMatrix(t *testing.T) {
+	m := Mat4(Mat4FromTranslation(Mat4Create(), []float64{5, -10.5, 100}))
+	if actual := fmt.Sprintf("%v", m); actual != Mat4Str(m) {
+		t.Errorf("format %%v: %v", actual)
+	}
+	expect := "mat4(\n" +
+		"  1  0  0      5\n" +
+		"  0  1  0  -10.5\n" +
+		"  0  0  1    100\n" +
+		"  0  0  0      1\n" +
+		")"
+	if actual := fmt.Sprintf("%+v", m); actual != expect {
+		t.Errorf("format %%+v: %v", actual)
+	}
+	expect = "mat2x3(\n" +
+		"  1.2  3.5  5\n" +
+		"    2    4  6\n" +
+		")"
+	if actual := fmt.Sprintf("%+.2v", Mat2x3([]float64{1.23, 3.5, 5, 2, 4, 6})); actual != expect {
+		t.Errorf("format mat2x3 %%+.2v: %v", actual)
+	}
+}
+
+func TestMat4StrRows(t *testing.T) {
+	m := Mat4FromTranslation(Mat4Create(), []float64{5, -10.5, 100})
+	expect := "1.00  0.00  0.00    5.00\n" +
+		"0.00  1.00  0.00  -10.50\n" +
+		"0.00  0.00  1.00  100.00\n" +
+		"0.00  0.00  0.00    1.00"
+	if actual := Mat4StrRows(m, 2); actual != expect {
+		t.Errorf("rows:\n%v", actual)
+	}
+}
+
+func TestMatStrRowsLayouts(t *testing.T) {
+	expect := "1  3  5\n2  4  6"
+	if actual := Mat2dStrRows(Mat2dFromValues(1, 2, 3, 4, 5, 6), -1); actual != expect {
+		t.Errorf("mat2d rows:\n%v", actual)
+	}
+	if actual := Mat2x3StrRows(mat2x3A, -1); actual != expect {
+		t.Errorf("mat2x3 rows:\n%v", actual)
+	}
+	if actual := Mat3x4StrRows(mat3x4A, -1); actual != Mat4x3StrRows(mat4x3A, -1) {
+		t.Errorf("mat3x4 rows:\n%v", actual)
+	}
+	if actual := Mat3StrRows([]float64{1, 2, 3, 4, 5, 6, 7, 8, 9}, -1); actual != "1  4  7\n2  5  8\n3  6  9" {
+		t.Errorf("mat3 rows:\n%v", actual)
+	}
+}
+
+func TestMat2StrLatex(t *testing.T) {
+	expect := "\\begin{bmatrix}\n1 & 3 \\\\\n2 & 4\n\\end{bmatrix}"
+	if actual := Mat2StrLatex([]float64{1, 2, 3, 4}, -1); actual != expect {
+		t.Errorf("latex:\n%v", actual)
+	}
+}
+
+func TestMat2StrMarkdown(t *testing.T) {
+	expect := "|   |   |\n|--:|--:|\n| 1.5 | 3.0 |\n| 2.0 | 4.0 |"
+	if actual := Mat2StrMarkdown([]float64{1.5, 2, 3, 4}, 1); actual != expect {
+		t.Errorf("markdown:\n%v", actual)
+	}
+}
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey) {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info("transform", "position", Vec3(vec3A), "matrix", Mat2([]float64{1, 2, 3, 4}))
+	expect := `{"msg":"transform","position":{"x":1,"y":2,"z":3},"matrix":[[1,3],[2,4]]}`
+	if actual := strings.TrimSpace(buf.String()); actual != expect {
+		t.Errorf("log: %v", actual)
+	}
+}
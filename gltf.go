@@ -0,0 +1,713 @@
+package glmatrix
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// GLTF is the node hierarchy, the accessors and the animations of a glTF 2.0
+// asset. glTF uses the conventions of this package: column-major matrices,
+// quaternions in x, y, z, w order and vec3 translations and scales.
+type GLTF struct {
+	// Scene is the index of the default scene, or -1
+	Scene      int
+	Scenes     []GLTFScene
+	Nodes      []GLTFNode
+	Accessors  []GLTFAccessor
+	Animations []GLTFAnimation
+
+	buffers     [][]byte
+	bufferViews []gltfBufferView
+}
+
+// GLTFScene is a set of root nodes
+type GLTFScene struct {
+	Name  string
+	Nodes []int
+}
+
+// GLTFNode is a node of the hierarchy. Its local transformation is either
+// Matrix or, if Matrix is nil, the composition of Translation, Rotation and
+// Scale, which are the only ones animations can target.
+type GLTFNode struct {
+	Name string
+
+	// Parent is the index of the parent node, or -1 for a root node
+	Parent   int
+	Children []int
+
+	// Mesh, Skin and Camera are the indices of the attached objects, or -1
+	Mesh   int
+	Skin   int
+	Camera int
+
+	Matrix      []float64
+	Translation []float64
+	Rotation    []float64
+	Scale       []float64
+
+	// Weights are the morph target weights
+	Weights []float64
+}
+
+// GLTFAccessor describes typed elements stored in a buffer
+type GLTFAccessor struct {
+	Name string
+
+	// Type is SCALAR, VEC2, VEC3, VEC4, MAT2, MAT3 or MAT4
+	Type string
+
+	// ComponentType is the GL enum of the type of the components, e.g. 5126 for float
+	ComponentType int
+	Normalized    bool
+	Count         int
+	Min           []float64
+	Max           []float64
+
+	bufferView int
+	byteOffset int
+	sparse     *gltfSparse
+}
+
+// GLTFAnimation is a set of keyframe animations of node properties
+type GLTFAnimation struct {
+	Name     string
+	Channels []GLTFChannel
+	Samplers []GLTFSampler
+}
+
+// GLTFChannel connects a sampler to a node property
+type GLTFChannel struct {
+	Sampler int
+
+	// Node is the index of the animated node, or -1 if it is not specified
+	Node int
+
+	// Path is translation, rotation, scale or weights
+	Path string
+}
+
+// GLTFSampler is a keyframe interpolation. Output holds the values of all the
+// keyframes one after another, which for CUBICSPLINE interpolation are the in
+// tangent, the value and the out tangent of each keyframe.
+type GLTFSampler struct {
+	Input  []float64
+	Output []float64
+
+	// Interpolation is LINEAR, STEP or CUBICSPLINE
+	Interpolation string
+}
+
+type gltfBufferView struct {
+	Buffer     int `json:"buffer"`
+	ByteOffset int `json:"byteOffset"`
+	ByteLength int `json:"byteLength"`
+	ByteStride int `json:"byteStride"`
+}
+
+type gltfSparse struct {
+	Count   int `json:"count"`
+	Indices struct {
+		BufferView    int `json:"bufferView"`
+		ByteOffset    int `json:"byteOffset"`
+		ComponentType int `json:"componentType"`
+	} `json:"indices"`
+	Values struct {
+		BufferView int `json:"bufferView"`
+		ByteOffset int `json:"byteOffset"`
+	} `json:"values"`
+}
+
+type gltfDocument struct {
+	Asset struct {
+		Version string `json:"version"`
+	} `json:"asset"`
+	Scene  *int `json:"scene"`
+	Scenes []struct {
+		Name  string `json:"name"`
+		Nodes []int  `json:"nodes"`
+	} `json:"scenes"`
+	Nodes []struct {
+		Name        string    `json:"name"`
+		Children    []int     `json:"children"`
+		Mesh        *int      `json:"mesh"`
+		Skin        *int      `json:"skin"`
+		Camera      *int      `json:"camera"`
+		Matrix      []float64 `json:"matrix"`
+		Translation []float64 `json:"translation"`
+		Rotation    []float64 `json:"rotation"`
+		Scale       []float64 `json:"scale"`
+		Weights     []float64 `json:"weights"`
+	} `json:"nodes"`
+	Buffers []struct {
+		URI        string `json:"uri"`
+		ByteLength int    `json:"byteLength"`
+	} `json:"buffers"`
+	BufferViews []gltfBufferView `json:"bufferViews"`
+	Accessors   []struct {
+		Name          string      `json:"name"`
+		BufferView    *int        `json:"bufferView"`
+		ByteOffset    int         `json:"byteOffset"`
+		ComponentType int         `json:"componentType"`
+		Normalized    bool        `json:"normalized"`
+		Count         int         `json:"count"`
+		Type          string      `json:"type"`
+		Min           []float64   `json:"min"`
+		Max           []float64   `json:"max"`
+		Sparse        *gltfSparse `json:"sparse"`
+	} `json:"accessors"`
+	Animations []struct {
+		Name     string `json:"name"`
+		Channels []struct {
+			Sampler int `json:"sampler"`
+			Target  struct {
+				Node *int   `json:"node"`
+				Path string `json:"path"`
+			} `json:"target"`
+		} `json:"channels"`
+		Samplers []struct {
+			Input         int    `json:"input"`
+			Output        int    `json:"output"`
+			Interpolation string `json:"interpolation"`
+		} `json:"samplers"`
+	} `json:"animations"`
+}
+
+// gltfComponents are the number of components of the accessor types
+var gltfComponents = map[string]int{
+	"SCALAR": 1, "VEC2": 2, "VEC3": 3, "VEC4": 4, "MAT2": 4, "MAT3": 9, "MAT4": 16,
+}
+
+// gltfComponentSizes are the sizes in bytes of the accessor component types
+var gltfComponentSizes = map[int]int{
+	5120: 1, // BYTE
+	5121: 1, // UNSIGNED_BYTE
+	5122: 2, // SHORT
+	5123: 2, // UNSIGNED_SHORT
+	5125: 4, // UNSIGNED_INT
+	5126: 4, // FLOAT
+}
+
+// gltfPathComponents are the number of components of the animated properties
+var gltfPathComponents = map[string]int{
+	"translation": 3, "rotation": 4, "scale": 3,
+}
+
+func gltfIndex(index *int) int {
+	if index == nil {
+		return -1
+	}
+	return *index
+}
+
+// ReadGLTF reads a glTF asset from a .gltf JSON document or a .glb binary.
+// Buffers are taken from the binary chunk of a GLB file, from data URIs or from
+// resolve, which is called with the URI of external buffers and may be nil if
+// there are none. The accessors of the animation samplers are read here while
+// other accessors are read on demand with ReadAccessor.
+func ReadGLTF(data []byte, resolve func(uri string) ([]byte, error)) (*GLTF, error) {
+	var bin []byte
+	if bytes.HasPrefix(data, []byte("glTF")) {
+		var err error
+		if data, bin, err = readGLB(data); err != nil {
+			return nil, err
+		}
+	}
+	var doc gltfDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("glmatrix: invalid glTF: %v", err)
+	}
+	if !strings.HasPrefix(doc.Asset.Version, "2.") {
+		return nil, fmt.Errorf("glmatrix: unsupported glTF version %q", doc.Asset.Version)
+	}
+
+	g := &GLTF{Scene: gltfIndex(doc.Scene), bufferViews: doc.BufferViews}
+	for i, b := range doc.Buffers {
+		var data []byte
+		var err error
+		switch {
+		case b.URI == "" && i == 0 && bin != nil:
+			data = bin
+		case strings.HasPrefix(b.URI, "data:"):
+			comma := strings.IndexByte(b.URI, ',')
+			if comma < 0 || !strings.HasSuffix(b.URI[:comma], ";base64") {
+				return nil, fmt.Errorf("glmatrix: invalid glTF: buffer %v has an unsupported data URI", i)
+			}
+			data, err = base64.StdEncoding.DecodeString(b.URI[comma+1:])
+		case b.URI == "":
+			return nil, fmt.Errorf("glmatrix: invalid glTF: buffer %v has no data", i)
+		case resolve == nil:
+			return nil, fmt.Errorf("glmatrix: glTF buffer %v refers to %q but no resolver is given", i, b.URI)
+		default:
+			data, err = resolve(b.URI)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("glmatrix: glTF buffer %v: %w", i, err)
+		}
+		if len(data) < b.ByteLength {
+			return nil, fmt.Errorf("glmatrix: invalid glTF: buffer %v has %v bytes but needs %v", i, len(data), b.ByteLength)
+		}
+		g.buffers = append(g.buffers, data)
+	}
+	for i, v := range g.bufferViews {
+		if v.Buffer < 0 || v.Buffer >= len(g.buffers) || v.ByteOffset < 0 || v.ByteLength < 0 ||
+			v.ByteOffset+v.ByteLength > len(g.buffers[v.Buffer]) {
+			return nil, fmt.Errorf("glmatrix: invalid glTF: buffer view %v is out of range", i)
+		}
+	}
+
+	for _, s := range doc.Scenes {
+		g.Scenes = append(g.Scenes, GLTFScene{Name: s.Name, Nodes: s.Nodes})
+	}
+	for _, n := range doc.Nodes {
+		node := GLTFNode{
+			Name:        n.Name,
+			Parent:      -1,
+			Children:    n.Children,
+			Mesh:        gltfIndex(n.Mesh),
+			Skin:        gltfIndex(n.Skin),
+			Camera:      gltfIndex(n.Camera),
+			Translation: Vec3Create(),
+			Rotation:    QuatCreate(),
+			Scale:       Vec3FromValues(1, 1, 1),
+			Weights:     n.Weights,
+		}
+		for _, p := range []struct {
+			name  string
+			in    []float64
+			out   []float64
+			count int
+		}{
+			{"translation", n.Translation, node.Translation, 3},
+			{"rotation", n.Rotation, node.Rotation, 4},
+			{"scale", n.Scale, node.Scale, 3},
+			{"matrix", n.Matrix, nil, 16},
+		} {
+			if p.in != nil && len(p.in) != p.count {
+				return nil, fmt.Errorf("glmatrix: invalid glTF: %v of node %v must have %v values", p.name, len(g.Nodes), p.count)
+			}
+			if p.in != nil && p.out != nil {
+				copy(p.out, p.in)
+			}
+		}
+		node.Matrix = n.Matrix
+		g.Nodes = append(g.Nodes, node)
+	}
+	if err := g.linkNodes(); err != nil {
+		return nil, err
+	}
+
+	for _, a := range doc.Accessors {
+		g.Accessors = append(g.Accessors, GLTFAccessor{
+			Name:          a.Name,
+			Type:          a.Type,
+			ComponentType: a.ComponentType,
+			Normalized:    a.Normalized,
+			Count:         a.Count,
+			Min:           a.Min,
+			Max:           a.Max,
+			bufferView:    gltfIndex(a.BufferView),
+			byteOffset:    a.ByteOffset,
+			sparse:        a.Sparse,
+		})
+	}
+
+	for i, a := range doc.Animations {
+		animation := GLTFAnimation{Name: a.Name}
+		for j, s := range a.Samplers {
+			sampler := GLTFSampler{Interpolation: s.Interpolation}
+			if sampler.Interpolation == "" {
+				sampler.Interpolation = "LINEAR"
+			}
+			var err error
+			if sampler.Input, err = g.ReadAccessor(s.Input); err != nil {
+				return nil, err
+			}
+			if sampler.Output, err = g.ReadAccessor(s.Output); err != nil {
+				return nil, err
+			}
+			keys := len(sampler.Input)
+			switch sampler.Interpolation {
+			case "CUBICSPLINE":
+				keys *= 3
+			case "LINEAR", "STEP":
+			default:
+				return nil, fmt.Errorf("glmatrix: invalid glTF: sampler %v of animation %v has unknown interpolation %q", j, i, s.Interpolation)
+			}
+			if keys == 0 || len(sampler.Output)%keys != 0 || !sort.Float64sAreSorted(sampler.Input) {
+				return nil, fmt.Errorf("glmatrix: invalid glTF: sampler %v of animation %v has invalid keyframes", j, i)
+			}
+			animation.Samplers = append(animation.Samplers, sampler)
+		}
+		for j, c := range a.Channels {
+			channel := GLTFChannel{Sampler: c.Sampler, Node: gltfIndex(c.Target.Node), Path: c.Target.Path}
+			if channel.Sampler < 0 || channel.Sampler >= len(animation.Samplers) || channel.Node >= len(g.Nodes) {
+				return nil, fmt.Errorf("glmatrix: invalid glTF: channel %v of animation %v is out of range", j, i)
+			}
+			if n, ok := gltfPathComponents[channel.Path]; ok && animation.components(channel) != n {
+				return nil, fmt.Errorf("glmatrix: invalid glTF: channel %v of animation %v has %v values per keyframe for %v",
+					j, i, animation.components(channel), channel.Path)
+			}
+			animation.Channels = append(animation.Channels, channel)
+		}
+		g.Animations = append(g.Animations, animation)
+	}
+	return g, nil
+}
+
+// readGLB splits a GLB file into its JSON and binary chunks
+func readGLB(data []byte) ([]byte, []byte, error) {
+	if len(data) < 12 {
+		return nil, nil, fmt.Errorf("glmatrix: invalid GLB: truncated header")
+	}
+	if version := binary.LittleEndian.Uint32(data[4:]); version != 2 {
+		return nil, nil, fmt.Errorf("glmatrix: unsupported GLB version %v", version)
+	}
+	length := binary.LittleEndian.Uint32(data[8:])
+	if uint64(length) > uint64(len(data)) {
+		return nil, nil, fmt.Errorf("glmatrix: invalid GLB: truncated to %v of %v bytes", len(data), length)
+	}
+	data = data[:length]
+	var doc, bin []byte
+	for offset := 12; offset < len(data); {
+		if offset+8 > len(data) {
+			return nil, nil, fmt.Errorf("glmatrix: invalid GLB: truncated chunk header at %v", offset)
+		}
+		length := int(binary.LittleEndian.Uint32(data[offset:]))
+		typ := binary.LittleEndian.Uint32(data[offset+4:])
+		offset += 8
+		if length < 0 || length > len(data)-offset {
+			return nil, nil, fmt.Errorf("glmatrix: invalid GLB: truncated chunk at %v", offset)
+		}
+		switch {
+		case typ == 0x4E4F534A && doc == nil: // JSON
+			doc = data[offset : offset+length]
+		case typ == 0x004E4942 && bin == nil: // BIN
+			bin = data[offset : offset+length]
+		}
+		offset += length
+	}
+	if doc == nil {
+		return nil, nil, fmt.Errorf("glmatrix: invalid GLB: missing JSON chunk")
+	}
+	return doc, bin, nil
+}
+
+// linkNodes sets the parents of the nodes and checks that they form trees
+func (g *GLTF) linkNodes() error {
+	for i := range g.Nodes {
+		for _, child := range g.Nodes[i].Children {
+			if child < 0 || child >= len(g.Nodes) {
+				return fmt.Errorf("glmatrix: invalid glTF: child %v of node %v is out of range", child, i)
+			}
+			if g.Nodes[child].Parent >= 0 {
+				return fmt.Errorf("glmatrix: invalid glTF: node %v has more than one parent", child)
+			}
+			g.Nodes[child].Parent = i
+		}
+	}
+	for i := range g.Nodes {
+		// a node is in a cycle if walking up the parents takes more steps than there are nodes
+		steps := 0
+		for n := i; n >= 0; n = g.Nodes[n].Parent {
+			if steps++; steps > len(g.Nodes) {
+				return fmt.Errorf("glmatrix: invalid glTF: node %v is in a cycle", i)
+			}
+		}
+	}
+	for i, s := range g.Scenes {
+		for _, n := range s.Nodes {
+			if n < 0 || n >= len(g.Nodes) || g.Nodes[n].Parent >= 0 {
+				return fmt.Errorf("glmatrix: invalid glTF: node %v of scene %v is not a root node", n, i)
+			}
+		}
+	}
+	if g.Scene >= len(g.Scenes) {
+		return fmt.Errorf("glmatrix: invalid glTF: default scene %v is out of range", g.Scene)
+	}
+	return nil
+}
+
+// LocalMatrix calculates the local transformation of a node
+func (n *GLTFNode) LocalMatrix(out []float64) []float64 {
+	if n.Matrix != nil {
+		return Mat4Copy(out, n.Matrix)
+	}
+	return Mat4FromRotationTranslationScale(out, n.Rotation, n.Translation, n.Scale)
+}
+
+// WorldMatrices calculates the transformations of all the nodes from their
+// local space to the space of the scene, indexed like Nodes
+func (g *GLTF) WorldMatrices() [][]float64 {
+	world := make([][]float64, len(g.Nodes))
+	var calc func(i int) []float64
+	calc = func(i int) []float64 {
+		if world[i] == nil {
+			n := &g.Nodes[i]
+			world[i] = n.LocalMatrix(Mat4Create())
+			if n.Parent >= 0 {
+				Mat4Multiply(world[i], calc(n.Parent), world[i])
+			}
+		}
+		return world[i]
+	}
+	for i := range g.Nodes {
+		calc(i)
+	}
+	return world
+}
+
+// ReadAccessor reads the elements of an accessor into a flat slice with the
+// components of each element one after another. Normalized integers are
+// converted into [0, 1] or [-1, 1] and sparse values are applied.
+func (g *GLTF) ReadAccessor(index int) ([]float64, error) {
+	if index < 0 || index >= len(g.Accessors) {
+		return nil, fmt.Errorf("glmatrix: glTF accessor %v is out of range", index)
+	}
+	a := &g.Accessors[index]
+	errorf := func(format string, args ...interface{}) error {
+		return fmt.Errorf("glmatrix: invalid glTF: accessor %v: %v", index, fmt.Sprintf(format, args...))
+	}
+	n, ok := gltfComponents[a.Type]
+	if !ok {
+		return nil, errorf("unknown type %q", a.Type)
+	}
+	size, ok := gltfComponentSizes[a.ComponentType]
+	if !ok {
+		return nil, errorf("unknown component type %v", a.ComponentType)
+	}
+	if a.Count < 0 {
+		return nil, errorf("negative count")
+	}
+
+	// the columns of matrices are aligned to 4 bytes
+	rows, columns := n, 1
+	switch a.Type {
+	case "MAT2":
+		rows, columns = 2, 2
+	case "MAT3":
+		rows, columns = 3, 3
+	case "MAT4":
+		rows, columns = 4, 4
+	}
+	columnSize := alignOffset(rows*size, 4)
+	if columns == 1 {
+		columnSize = rows * size
+	}
+	elementSize := columnSize * columns
+
+	var data []byte
+	stride := 0
+	if a.bufferView >= 0 {
+		var err error
+		if data, stride, err = g.view(a.bufferView, a.byteOffset, a.Count, elementSize); err != nil {
+			return nil, errorf("%v", err)
+		}
+	}
+	out := make([]float64, a.Count*n)
+	if data != nil {
+		for i := 0; i < a.Count; i++ {
+			for c := 0; c < columns; c++ {
+				for r := 0; r < rows; r++ {
+					out[i*n+c*rows+r] = a.component(data[i*stride+c*columnSize+r*size:])
+				}
+			}
+		}
+	}
+
+	if s := a.sparse; s != nil {
+		if s.Count < 0 || s.Count > a.Count {
+			return nil, errorf("invalid sparse count %v", s.Count)
+		}
+		indexSize, ok := gltfComponentSizes[s.Indices.ComponentType]
+		if !ok || s.Indices.ComponentType == 5126 {
+			return nil, errorf("invalid sparse index component type %v", s.Indices.ComponentType)
+		}
+		indices, _, err := g.view(s.Indices.BufferView, s.Indices.ByteOffset, s.Count, indexSize)
+		if err != nil {
+			return nil, errorf("sparse indices: %v", err)
+		}
+		values, _, err := g.view(s.Values.BufferView, s.Values.ByteOffset, s.Count, elementSize)
+		if err != nil {
+			return nil, errorf("sparse values: %v", err)
+		}
+		indexAccessor := GLTFAccessor{ComponentType: s.Indices.ComponentType}
+		for i := 0; i < s.Count; i++ {
+			target := int(indexAccessor.component(indices[i*indexSize:]))
+			if target >= a.Count {
+				return nil, errorf("sparse index %v is out of range", target)
+			}
+			for c := 0; c < columns; c++ {
+				for r := 0; r < rows; r++ {
+					out[target*n+c*rows+r] = a.component(values[i*elementSize+c*columnSize+r*size:])
+				}
+			}
+		}
+	}
+	return out, nil
+}
+
+// view returns the bytes of count elements of the given size in a buffer view
+// starting at offset and the stride between the elements
+func (g *GLTF) view(index, offset, count, size int) ([]byte, int, error) {
+	if index < 0 || index >= len(g.bufferViews) {
+		return nil, 0, fmt.Errorf("buffer view %v is out of range", index)
+	}
+	v := g.bufferViews[index]
+	stride := size
+	if v.ByteStride > 0 {
+		stride = v.ByteStride
+	}
+	data := g.buffers[v.Buffer][v.ByteOffset : v.ByteOffset+v.ByteLength]
+	if offset < 0 || count > 0 && offset+(count-1)*stride+size > len(data) {
+		return nil, 0, fmt.Errorf("%v elements of %v bytes at %v exceed buffer view %v", count, size, offset, index)
+	}
+	return data[offset:], stride, nil
+}
+
+// component decodes a component of the accessor
+func (a *GLTFAccessor) component(b []byte) float64 {
+	order := binary.LittleEndian
+	var v, max float64
+	signed := false
+	switch a.ComponentType {
+	case 5120:
+		v, max, signed = float64(int8(b[0])), 127, true
+	case 5121:
+		v, max = float64(b[0]), 255
+	case 5122:
+		v, max, signed = float64(int16(order.Uint16(b))), 32767, true
+	case 5123:
+		v, max = float64(order.Uint16(b)), 65535
+	case 5125:
+		v, max = float64(order.Uint32(b)), 4294967295
+	default:
+		return float64(math.Float32frombits(order.Uint32(b)))
+	}
+	if !a.Normalized {
+		return v
+	}
+	if signed {
+		return unsnorm(v, max)
+	}
+	return v / max
+}
+
+// components returns the number of values per keyframe of a channel
+func (a *GLTFAnimation) components(c GLTFChannel) int {
+	s := &a.Samplers[c.Sampler]
+	n := len(s.Output) / len(s.Input)
+	if s.Interpolation == "CUBICSPLINE" {
+		n /= 3
+	}
+	return n
+}
+
+// Duration returns the time of the last keyframe of an animation
+func (a *GLTFAnimation) Duration() float64 {
+	d := 0.
+	for _, s := range a.Samplers {
+		d = math.Max(d, s.Input[len(s.Input)-1])
+	}
+	return d
+}
+
+// Sample interpolates the value of a channel at the given time, which is
+// clamped to the keyframes. Rotations are interpolated with QuatSlerp and
+// normalized. out must have as many values as a keyframe.
+func (a *GLTFAnimation) Sample(out []float64, channel int, t float64) []float64 {
+	c := a.Channels[channel]
+	s := &a.Samplers[c.Sampler]
+	n := a.components(c)
+	keys := s.Input
+	stride := n
+	offset := 0
+	if s.Interpolation == "CUBICSPLINE" {
+		stride = n * 3
+		offset = n
+	}
+	value := func(k int) []float64 {
+		return s.Output[k*stride+offset : k*stride+offset+n]
+	}
+
+	last := len(keys) - 1
+	k := sort.SearchFloat64s(keys, t)
+	switch {
+	case t <= keys[0]:
+		k = 0
+	case t >= keys[last]:
+		k = last
+	case keys[k] != t:
+		k--
+	}
+	if k == last || keys[k] >= t {
+		copy(out, value(k))
+		return out
+	}
+	dt := keys[k+1] - keys[k]
+	u := (t - keys[k]) / dt
+
+	switch {
+	case s.Interpolation == "STEP":
+		copy(out, value(k))
+	case s.Interpolation == "CUBICSPLINE":
+		// the out tangent of k and the in tangent of k+1 are scaled by the interval
+		u2 := u * u
+		u3 := u2 * u
+		h00 := 2*u3 - 3*u2 + 1
+		h10 := u3 - 2*u2 + u
+		h01 := -2*u3 + 3*u2
+		h11 := u3 - u2
+		p0 := value(k)
+		p1 := value(k + 1)
+		m0 := s.Output[k*stride+2*n : k*stride+3*n]
+		m1 := s.Output[(k+1)*stride : (k+1)*stride+n]
+		for i := 0; i < n; i++ {
+			out[i] = h00*p0[i] + h10*dt*m0[i] + h01*p1[i] + h11*dt*m1[i]
+		}
+		if c.Path == "rotation" {
+			QuatNormalize(out, out)
+		}
+	case c.Path == "rotation":
+		QuatNormalize(out, QuatSlerp(out, value(k), value(k+1), u))
+	default:
+		p0 := value(k)
+		p1 := value(k + 1)
+		for i := 0; i < n; i++ {
+			out[i] = p0[i] + (p1[i]-p0[i])*u
+		}
+	}
+	return out
+}
+
+// Animate sets the properties of the nodes targeted by an animation to their
+// values at the given time. Channels without a node are ignored and the
+// weights of a node are resized to the number of values of a keyframe.
+func (g *GLTF) Animate(animation int, t float64) {
+	a := &g.Animations[animation]
+	for i, c := range a.Channels {
+		if c.Node < 0 {
+			continue
+		}
+		n := &g.Nodes[c.Node]
+		switch c.Path {
+		case "translation":
+			a.Sample(n.Translation, i, t)
+		case "rotation":
+			a.Sample(n.Rotation, i, t)
+		case "scale":
+			a.Sample(n.Scale, i, t)
+		case "weights":
+			if len(n.Weights) != a.components(c) {
+				n.Weights = make([]float64, a.components(c))
+			}
+			a.Sample(n.Weights, i, t)
+		}
+	}
+}
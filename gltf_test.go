@@ -0,0 +1,285 @@
+package glmatrix
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+)
+
+// testGLTFBuffer holds the keyframe times, translations, rotations and a
+// sparse accessor used by testGLTF
+func testGLTFBuffer() []byte {
+	s := math.Sqrt(0.5)
+	values := []float64{
+		0, 1, 2, // times
+		0, 0, 0, 10, 0, 0, 10, 10, 0, // translations
+		0, 0, 0, 1, 0, 0, s, s, 0, 0, 1, 0, // rotations
+	}
+	buf := BinaryFormat{Float32: true}.Append(nil, values)
+	// 2 sparse indices and their values, then 4 normalized bytes
+	buf = binary.LittleEndian.AppendUint16(buf, 0)
+	buf = binary.LittleEndian.AppendUint16(buf, 2)
+	buf = BinaryFormat{Float32: true}.Append(buf, []float64{5, 6})
+	return append(buf, 0, 127, 0x81, 0x80)
+}
+
+const testGLTFTemplate = `{
+	"asset": {"version": "2.0"},
+	"scene": 0,
+	"scenes": [{"name": "main", "nodes": [0, 3]}],
+	"nodes": [
+		{"name": "root", "children": [1], "translation": [1, 2, 3], "scale": [2, 2, 2]},
+		{"name": "arm", "children": [2], "rotation": [0, 0, 0.7071067811865476, 0.7071067811865476], "mesh": 0},
+		{"name": "hand", "matrix": [1, 0, 0, 0, 0, 1, 0, 0, 0, 0, 1, 0, 1, 0, 0, 1]},
+		{"name": "other"}
+	],
+	"buffers": [%v],
+	"bufferViews": [
+		{"buffer": 0, "byteOffset": 0, "byteLength": 96},
+		{"buffer": 0, "byteOffset": 96, "byteLength": 12},
+		{"buffer": 0, "byteOffset": 108, "byteLength": 4}
+	],
+	"accessors": [
+		{"bufferView": 0, "componentType": 5126, "count": 3, "type": "SCALAR", "min": [0], "max": [2]},
+		{"bufferView": 0, "byteOffset": 12, "componentType": 5126, "count": 3, "type": "VEC3"},
+		{"bufferView": 0, "byteOffset": 48, "componentType": 5126, "count": 3, "type": "VEC4"},
+		{"componentType": 5126, "count": 3, "type": "SCALAR",
+			"sparse": {"count": 2, "indices": {"bufferView": 1, "componentType": 5123}, "values": {"bufferView": 1, "byteOffset": 4}}},
+		{"bufferView": 2, "componentType": 5120, "normalized": true, "count": 4, "type": "SCALAR"},
+		{"bufferView": 2, "componentType": 5121, "count": 4, "type": "SCALAR"}
+	],
+	"animations": [{
+		"name": "walk",
+		"channels": [
+			{"sampler": 0, "target": {"node": 0, "path": "translation"}},
+			{"sampler": 1, "target": {"node": 1, "path": "rotation"}},
+			{"sampler": 2, "target": {"node": 3, "path": "translation"}}
+		],
+		"samplers": [
+			{"input": 0, "output": 1},
+			{"input": 0, "output": 2, "interpolation": "LINEAR"},
+			{"input": 0, "output": 1, "interpolation": "STEP"}
+		]
+	}]
+}`
+
+func testGLTF(t *testing.T) *GLTF {
+	uri := fmt.Sprintf(`{"uri": "data:application/octet-stream;base64,%v", "byteLength": 112}`,
+		base64.StdEncoding.EncodeToString(testGLTFBuffer()))
+	g, err := ReadGLTF([]byte(fmt.Sprintf(testGLTFTemplate, uri)), nil)
+	if err != nil {
+		t.Fatalf("read gltf: %v", err)
+	}
+	return g
+}
+
+func TestReadGLTF(t *testing.T) {
+	g := testGLTF(t)
+	if g.Scene != 0 || len(g.Scenes) != 1 || g.Scenes[0].Name != "main" || !testIntSlice(g.Scenes[0].Nodes, []int{0, 3}) {
+		t.Errorf("read gltf scenes: %v %v", g.Scene, g.Scenes)
+	}
+	parents := []int{-1, 0, 1, -1}
+	meshes := []int{-1, 0, -1, -1}
+	for i, n := range g.Nodes {
+		if n.Parent != parents[i] || n.Mesh != meshes[i] || n.Skin != -1 || n.Camera != -1 {
+			t.Errorf("read gltf node %v: %v", i, n)
+		}
+	}
+	if !testSlice(g.Nodes[3].Translation, []float64{0, 0, 0}) || !testSlice(g.Nodes[3].Rotation, []float64{0, 0, 0, 1}) ||
+		!testSlice(g.Nodes[3].Scale, []float64{1, 1, 1}) || g.Nodes[3].Matrix != nil {
+		t.Errorf("read gltf default trs: %v", g.Nodes[3])
+	}
+	if len(g.Accessors) != 6 || g.Accessors[0].Type != "SCALAR" || !testSlice(g.Accessors[0].Max, []float64{2}) {
+		t.Errorf("read gltf accessors: %v", g.Accessors)
+	}
+	a := g.Animations[0]
+	if a.Name != "walk" || len(a.Channels) != 3 || a.Samplers[0].Interpolation != "LINEAR" ||
+		!testSlice(a.Samplers[0].Input, []float64{0, 1, 2}) || len(a.Samplers[1].Output) != 12 || a.Duration() != 2 {
+		t.Errorf("read gltf animations: %v", a)
+	}
+}
+
+func TestGLTFWorldMatrices(t *testing.T) {
+	g := testGLTF(t)
+	world := g.WorldMatrices()
+	root := Mat4FromRotationTranslationScale(Mat4Create(), QuatCreate(), []float64{1, 2, 3}, []float64{2, 2, 2})
+	arm := Mat4Multiply(Mat4Create(), root, Mat4FromZRotation(Mat4Create(), math.Pi/2))
+	hand := Mat4Translate(Mat4Create(), arm, []float64{1, 0, 0})
+	for i, expect := range [][]float64{root, arm, hand, Mat4Create()} {
+		if !testSlice(world[i], expect) {
+			t.Errorf("world matrix %v: %v", i, world[i])
+		}
+	}
+	p := Vec3TransformMat4(Vec3Create(), Vec3Create(), world[2])
+	if !testSlice(p, []float64{1, 4, 3}) {
+		t.Errorf("world matrix hand origin: %v", p)
+	}
+}
+
+func TestGLTFReadAccessor(t *testing.T) {
+	g := testGLTF(t)
+	cases := map[int][]float64{
+		1: {0, 0, 0, 10, 0, 0, 10, 10, 0},
+		3: {5, 0, 6},
+		4: {0, 1, -1, -1},
+		5: {0, 127, 129, 128},
+	}
+	for index, expect := range cases {
+		actual, err := g.ReadAccessor(index)
+		if err != nil || !testSlice(actual, expect) {
+			t.Errorf("read accessor %v: %v %v", index, actual, err)
+		}
+	}
+	if _, err := g.ReadAccessor(6); err == nil {
+		t.Errorf("read accessor out of range: %v", err)
+	}
+	g.Accessors[1].Count = 8
+	if _, err := g.ReadAccessor(1); err == nil || !strings.Contains(err.Error(), "exceed buffer view 0") {
+		t.Errorf("read accessor overflow: %v", err)
+	}
+}
+
+func TestGLTFReadAccessorMatrix(t *testing.T) {
+	g := &GLTF{
+		Accessors:   []GLTFAccessor{{Type: "MAT3", ComponentType: 5121, Count: 2, bufferView: 0}},
+		buffers:     [][]byte{{1, 2, 3, 0, 4, 5, 6, 0, 7, 8, 9, 0, 10, 11, 12, 0, 13, 14, 15, 0, 16, 17, 18, 0}},
+		bufferViews: []gltfBufferView{{ByteLength: 24}},
+	}
+	actual, err := g.ReadAccessor(0)
+	expect := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18}
+	if err != nil || !testSlice(actual, expect) {
+		t.Errorf("read accessor mat3: %v %v", actual, err)
+	}
+	g.bufferViews[0].ByteStride = 16
+	g.Accessors[0].Type = "VEC3"
+	actual, err = g.ReadAccessor(0)
+	if err != nil || !testSlice(actual, []float64{1, 2, 3, 13, 14, 15}) {
+		t.Errorf("read accessor stride: %v %v", actual, err)
+	}
+}
+
+func TestGLTFSample(t *testing.T) {
+	g := testGLTF(t)
+	a := &g.Animations[0]
+	cases := []struct {
+		channel int
+		t       float64
+		expect  []float64
+	}{
+		{0, -1, []float64{0, 0, 0}},
+		{0, 0.5, []float64{5, 0, 0}},
+		{0, 1, []float64{10, 0, 0}},
+		{0, 1.25, []float64{10, 2.5, 0}},
+		{0, 3, []float64{10, 10, 0}},
+		{1, 0.5, []float64{0, 0, math.Sin(math.Pi / 8), math.Cos(math.Pi / 8)}},
+		{1, 1.5, []float64{0, 0, math.Sin(3 * math.Pi / 8), math.Cos(3 * math.Pi / 8)}},
+		{2, 0.99, []float64{0, 0, 0}},
+		{2, 1.99, []float64{10, 0, 0}},
+		{2, 2, []float64{10, 10, 0}},
+	}
+	for _, c := range cases {
+		actual := a.Sample(make([]float64, len(c.expect)), c.channel, c.t)
+		if !testSlice(actual, c.expect) {
+			t.Errorf("sample %v at %v: %v", c.channel, c.t, actual)
+		}
+	}
+}
+
+func TestGLTFSampleCubicSpline(t *testing.T) {
+	// x = t^3 sampled at 0 and 2 with the derivatives 0 and 12 as tangents
+	a := &GLTFAnimation{
+		Channels: []GLTFChannel{{Sampler: 0, Node: 0, Path: "weights"}},
+		Samplers: []GLTFSampler{{Input: []float64{0, 2}, Output: []float64{0, 0, 0, 12, 8, 12}, Interpolation: "CUBICSPLINE"}},
+	}
+	for _, x := range []float64{0, 0.5, 1, 1.5, 2} {
+		actual := a.Sample([]float64{0}, 0, x)
+		if !testSlice(actual, []float64{x * x * x}) {
+			t.Errorf("sample cubic spline at %v: %v", x, actual)
+		}
+	}
+}
+
+func TestGLTFAnimate(t *testing.T) {
+	g := testGLTF(t)
+	g.Animate(0, 2)
+	world := g.WorldMatrices()
+	p := Vec3TransformMat4(Vec3Create(), Vec3Create(), world[2])
+	// the root is translated by (10, 10, 0) and the arm is rotated by 180 degrees
+	if !testSlice(p, []float64{8, 10, 0}) {
+		t.Errorf("animate hand origin: %v", p)
+	}
+	if !testSlice(g.Nodes[3].Translation, []float64{10, 10, 0}) {
+		t.Errorf("animate step: %v", g.Nodes[3].Translation)
+	}
+}
+
+func TestReadGLB(t *testing.T) {
+	doc := []byte(fmt.Sprintf(testGLTFTemplate, `{"byteLength": 112}`))
+	for len(doc)%4 != 0 {
+		doc = append(doc, ' ')
+	}
+	bin := testGLTFBuffer()
+	glb := []byte("glTF")
+	glb = binary.LittleEndian.AppendUint32(glb, 2)
+	glb = binary.LittleEndian.AppendUint32(glb, uint32(12+8+len(doc)+8+len(bin)))
+	glb = binary.LittleEndian.AppendUint32(glb, uint32(len(doc)))
+	glb = binary.LittleEndian.AppendUint32(glb, 0x4E4F534A)
+	glb = append(glb, doc...)
+	glb = binary.LittleEndian.AppendUint32(glb, uint32(len(bin)))
+	glb = binary.LittleEndian.AppendUint32(glb, 0x004E4942)
+	glb = append(glb, bin...)
+
+	g, err := ReadGLTF(glb, nil)
+	if err != nil {
+		t.Fatalf("read glb: %v", err)
+	}
+	actual, err := g.ReadAccessor(1)
+	if err != nil || !testSlice(actual, []float64{0, 0, 0, 10, 0, 0, 10, 10, 0}) {
+		t.Errorf("read glb accessor: %v %v", actual, err)
+	}
+	if _, err := ReadGLTF(glb[:len(glb)-1], nil); err == nil || !strings.Contains(err.Error(), "truncated") {
+		t.Errorf("read glb truncated: %v", err)
+	}
+}
+
+func TestReadGLTFResolve(t *testing.T) {
+	doc := []byte(fmt.Sprintf(testGLTFTemplate, `{"uri": "walk.bin", "byteLength": 112}`))
+	if _, err := ReadGLTF(doc, nil); err == nil || !strings.Contains(err.Error(), "no resolver") {
+		t.Errorf("read gltf without resolver: %v", err)
+	}
+	g, err := ReadGLTF(doc, func(uri string) ([]byte, error) {
+		if uri != "walk.bin" {
+			return nil, fmt.Errorf("not found")
+		}
+		return testGLTFBuffer(), nil
+	})
+	if err != nil || len(g.Animations) != 1 {
+		t.Errorf("read gltf with resolver: %v", err)
+	}
+}
+
+func TestReadGLTFErrors(t *testing.T) {
+	cases := map[string]string{
+		`{"asset": {"version": "1.0"}}`: "unsupported glTF version",
+		`{"asset": {"version": "2.0"}, "nodes": [{"children": [1]}, {"children": [0]}]}`:              "node 0 is in a cycle",
+		`{"asset": {"version": "2.0"}, "nodes": [{"children": [2]}, {"children": [2]}, {}]}`:          "node 2 has more than one parent",
+		`{"asset": {"version": "2.0"}, "nodes": [{"children": [3]}]}`:                                 "child 3 of node 0 is out of range",
+		`{"asset": {"version": "2.0"}, "nodes": [{"translation": [1, 2]}]}`:                           "translation of node 0 must have 3 values",
+		`{"asset": {"version": "2.0"}, "nodes": [{"children": [1]}, {}], "scenes": [{"nodes": [1]}]}`: "node 1 of scene 0 is not a root node",
+		`{"asset": {"version": "2.0"}, "scene": 0}`:                                                   "default scene 0 is out of range",
+		`{"asset": {"version": "2.0"}, "buffers": [{"byteLength": 4}]}`:                               "buffer 0 has no data",
+		`{"asset": {"version": "2.0"}, "buffers": [{"uri": "data:,abc", "byteLength": 3}]}`:           "unsupported data URI",
+		`{"asset": {"version": "2.0"}, "buffers": [{"uri": "data:;base64,AAAA", "byteLength": 4}]}`:   "buffer 0 has 3 bytes but needs 4",
+		`{"asset": {"version": "2.0"}, "bufferViews": [{"buffer": 0, "byteLength": 4}]}`:              "buffer view 0 is out of range",
+		`[]`: "invalid glTF",
+	}
+	for src, expect := range cases {
+		g, err := ReadGLTF([]byte(src), nil)
+		if g != nil || err == nil || !strings.Contains(err.Error(), expect) {
+			t.Errorf("read gltf %v: %v", src, err)
+		}
+	}
+}
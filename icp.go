@@ -0,0 +1,256 @@
+package glmatrix
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// ICPOptions represent the settings of Mat4ICP
+type ICPOptions struct {
+	// MaxIterations is the maximum number of iterations. Defaults to 50.
+	MaxIterations int
+
+	// Tolerance stops the iteration when the error changes less than this value.
+	// Defaults to 1e-6.
+	Tolerance float64
+
+	// MaxDistance rejects pairs whose points are farther apart than this value.
+	// Zero means no limit.
+	MaxDistance float64
+
+	// TrimRatio rejects the given fraction of the pairs with the largest
+	// distances on every iteration. Zero keeps every pair.
+	TrimRatio float64
+
+	// PointToPlane minimizes the distance to the tangent planes of the
+	// destination points instead of the distance to the points themselves.
+	// DstNormals must be given in that case.
+	PointToPlane bool
+
+	// DstNormals are the unit normals of the destination points.
+	DstNormals []float64
+
+	// Initial is the initial guess of the transformation. Defaults to identity.
+	Initial []float64
+}
+
+// ICPResult represent the outcome of Mat4ICP
+type ICPResult struct {
+	// Iterations is the number of iterations performed
+	Iterations int
+
+	// Errors holds the root mean square error of the accepted pairs
+	// measured at the start of each iteration
+	Errors []float64
+
+	// Converged reports whether the iteration stopped within the tolerance
+	Converged bool
+}
+
+// Mat4ICP aligns the point cloud src to the point cloud dst by the iterative
+// closest point algorithm and writes the rigid transformation mapping src onto
+// dst to out. Both clouds are flat arrays of vec3's in the same layout as
+// Vec3ForEach. opts may be nil to use the defaults.
+func Mat4ICP(out, src, dst []float64, opts *ICPOptions) *ICPResult {
+	if opts == nil {
+		opts = &ICPOptions{}
+	}
+	if len(src)%3 != 0 || len(dst)%3 != 0 {
+		panic(fmt.Sprintf("Point clouds must be multiples of 3 but got %v and %v", len(src), len(dst)))
+	}
+	if opts.PointToPlane && len(opts.DstNormals) != len(dst) {
+		panic(fmt.Sprintf("Expected %v normals but got %v", len(dst), len(opts.DstNormals)))
+	}
+	maxIterations := opts.MaxIterations
+	if maxIterations <= 0 {
+		maxIterations = 50
+	}
+	tolerance := opts.Tolerance
+	if tolerance <= 0 {
+		tolerance = 1e-6
+	}
+
+	if opts.Initial != nil {
+		Mat4Copy(out, opts.Initial)
+	} else {
+		Mat4Identity(out)
+	}
+	result := &ICPResult{}
+	if len(src) == 0 || len(dst) == 0 {
+		return result
+	}
+
+	tree := newKdTree(dst)
+	n := len(src) / 3
+	cur := make([]float64, len(src))
+	pairs := make([]icpPair, 0, n)
+	step := Mat4Create()
+	prevErr := math.Inf(1)
+	for result.Iterations < maxIterations {
+		for i := 0; i < n; i++ {
+			Vec3TransformMat4(cur[i*3:i*3+3], src[i*3:i*3+3], out)
+		}
+
+		pairs = pairs[:0]
+		for i := 0; i < n; i++ {
+			j, d2 := tree.nearest(cur[i*3 : i*3+3])
+			if opts.MaxDistance > 0 && d2 > opts.MaxDistance*opts.MaxDistance {
+				continue
+			}
+			pairs = append(pairs, icpPair{i, j, d2})
+		}
+		if 0 < opts.TrimRatio && opts.TrimRatio < 1 {
+			sort.Slice(pairs, func(a, b int) bool { return pairs[a].dist2 < pairs[b].dist2 })
+			pairs = pairs[:len(pairs)-int(float64(len(pairs))*opts.TrimRatio)]
+		}
+		if len(pairs) == 0 {
+			break
+		}
+
+		err := 0.
+		for _, p := range pairs {
+			if opts.PointToPlane {
+				d := Vec3Subtract(Vec3Create(), cur[p.src*3:p.src*3+3], dst[p.dst*3:p.dst*3+3])
+				r := Vec3Dot(d, opts.DstNormals[p.dst*3:p.dst*3+3])
+				err += r * r
+			} else {
+				err += p.dist2
+			}
+		}
+		err = math.Sqrt(err / float64(len(pairs)))
+		result.Errors = append(result.Errors, err)
+		result.Iterations++
+		if math.Abs(prevErr-err) < tolerance {
+			result.Converged = true
+			break
+		}
+		prevErr = err
+
+		var ok bool
+		if opts.PointToPlane {
+			ok = icpPointToPlaneStep(step, cur, dst, opts.DstNormals, pairs)
+		} else {
+			ok = icpPointToPointStep(step, cur, dst, pairs)
+		}
+		if !ok {
+			break
+		}
+		Mat4Multiply(out, step, out)
+	}
+	return result
+}
+
+type icpPair struct {
+	src   int
+	dst   int
+	dist2 float64
+}
+
+func icpPointToPointStep(out, cur, dst []float64, pairs []icpPair) bool {
+	a := make([]float64, 0, len(pairs)*3)
+	b := make([]float64, 0, len(pairs)*3)
+	for _, p := range pairs {
+		a = append(a, cur[p.src*3:p.src*3+3]...)
+		b = append(b, dst[p.dst*3:p.dst*3+3]...)
+	}
+	Mat4Kabsch(out, a, b, nil)
+	return true
+}
+
+// icpPointToPlaneStep solves the linearized point-to-plane problem
+// for the small rotation vector and translation
+func icpPointToPlaneStep(out, cur, dst, normals []float64, pairs []icpPair) bool {
+	ata := make([]float64, 36)
+	atb := make([]float64, 6)
+	row := make([]float64, 6)
+	c := Vec3Create()
+	for _, pair := range pairs {
+		p := cur[pair.src*3 : pair.src*3+3]
+		q := dst[pair.dst*3 : pair.dst*3+3]
+		n := normals[pair.dst*3 : pair.dst*3+3]
+		Vec3Cross(c, p, n)
+		row[0] = n[0]
+		row[1] = n[1]
+		row[2] = n[2]
+		row[3] = c[0]
+		row[4] = c[1]
+		row[5] = c[2]
+		r := (q[0]-p[0])*n[0] + (q[1]-p[1])*n[1] + (q[2]-p[2])*n[2]
+		for i := 0; i < 6; i++ {
+			for j := 0; j < 6; j++ {
+				ata[i*6+j] += row[i] * row[j]
+			}
+			atb[i] += row[i] * r
+		}
+	}
+	x := solveLinear(ata, atb, 6)
+	if x == nil {
+		return false
+	}
+	r := SO3Exp(Mat3Create(), x[3:6])
+	se3FromRotationTranslation(out, r, x[0:3])
+	return true
+}
+
+// kdTree is a static 3-d tree over a flat array of vec3's
+type kdTree struct {
+	points []float64
+	index  []int
+}
+
+func newKdTree(points []float64) *kdTree {
+	t := &kdTree{points: points, index: make([]int, len(points)/3)}
+	for i := range t.index {
+		t.index[i] = i
+	}
+	t.build(0, len(t.index), 0)
+	return t
+}
+
+func (t *kdTree) build(lo, hi, axis int) {
+	if hi-lo <= 1 {
+		return
+	}
+	s := t.index[lo:hi]
+	sort.Slice(s, func(a, b int) bool {
+		return t.points[s[a]*3+axis] < t.points[s[b]*3+axis]
+	})
+	mid := (lo + hi) / 2
+	t.build(lo, mid, (axis+1)%3)
+	t.build(mid+1, hi, (axis+1)%3)
+}
+
+// nearest returns the index of the closest point and its squared distance
+func (t *kdTree) nearest(p []float64) (int, float64) {
+	best := -1
+	bestD2 := math.Inf(1)
+	t.search(p, 0, len(t.index), 0, &best, &bestD2)
+	return best, bestD2
+}
+
+func (t *kdTree) search(p []float64, lo, hi, axis int, best *int, bestD2 *float64) {
+	if lo >= hi {
+		return
+	}
+	mid := (lo + hi) / 2
+	i := t.index[mid]
+	d2 := Vec3SquaredDistance(p, t.points[i*3:i*3+3])
+	if d2 < *bestD2 {
+		*best = i
+		*bestD2 = d2
+	}
+	diff := p[axis] - t.points[i*3+axis]
+	next := (axis + 1) % 3
+	if diff < 0 {
+		t.search(p, lo, mid, next, best, bestD2)
+		if diff*diff < *bestD2 {
+			t.search(p, mid+1, hi, next, best, bestD2)
+		}
+	} else {
+		t.search(p, mid+1, hi, next, best, bestD2)
+		if diff*diff < *bestD2 {
+			t.search(p, lo, mid, next, best, bestD2)
+		}
+	}
+}
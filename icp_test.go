@@ -0,0 +1,111 @@
+package glmatrix
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func ellipsoidCloud(n int) ([]float64, []float64) {
+	r := rand.New(rand.NewSource(1))
+	points := make([]float64, 0, n*3)
+	normals := make([]float64, 0, n*3)
+	for i := 0; i < n; i++ {
+		theta := r.Float64() * math.Pi
+		phi := r.Float64() * 2 * math.Pi
+		x := 3 * math.Sin(theta) * math.Cos(phi)
+		y := 2 * math.Sin(theta) * math.Sin(phi)
+		z := math.Cos(theta)
+		points = append(points, x, y, z)
+		normal := Vec3Normalize(Vec3Create(), []float64{x / 9, y / 4, z})
+		normals = append(normals, normal...)
+	}
+	return points, normals
+}
+
+func TestMat4ICPPointToPoint(t *testing.T) {
+	dst, _ := ellipsoidCloud(500)
+	expect := SE3Exp(Mat4Create(), []float64{0.1, -0.05, 0.08, 0.05, 0.1, -0.08})
+	inv := Mat4Invert(Mat4Create(), expect)
+	src := make([]float64, len(dst))
+	for i := 0; i < len(dst); i += 3 {
+		Vec3TransformMat4(src[i:i+3], dst[i:i+3], inv)
+	}
+
+	actual := Mat4Create()
+	result := Mat4ICP(actual, src, dst, &ICPOptions{MaxIterations: 100, Tolerance: 1e-10})
+	if !testSliceTol(actual, expect, 1e-4) {
+		t.Errorf("icp point to point: %v", actual)
+	}
+	if !result.Converged {
+		t.Errorf("icp point to point did not converge: %v", result.Errors)
+	}
+	if result.Errors[len(result.Errors)-1] > result.Errors[0] {
+		t.Errorf("icp point to point errors: %v", result.Errors)
+	}
+}
+
+func TestMat4ICPPointToPlane(t *testing.T) {
+	dst, normals := ellipsoidCloud(500)
+	expect := SE3Exp(Mat4Create(), []float64{0.1, -0.05, 0.08, 0.05, 0.1, -0.08})
+	inv := Mat4Invert(Mat4Create(), expect)
+	src := make([]float64, len(dst))
+	for i := 0; i < len(dst); i += 3 {
+		Vec3TransformMat4(src[i:i+3], dst[i:i+3], inv)
+	}
+
+	actual := Mat4Create()
+	result := Mat4ICP(actual, src, dst, &ICPOptions{
+		PointToPlane: true,
+		DstNormals:   normals,
+		Tolerance:    1e-10,
+	})
+	if !testSliceTol(actual, expect, 1e-4) {
+		t.Errorf("icp point to plane: %v", actual)
+	}
+	if !result.Converged {
+		t.Errorf("icp point to plane did not converge: %v", result.Errors)
+	}
+}
+
+func TestMat4ICPOutliers(t *testing.T) {
+	dst, _ := ellipsoidCloud(300)
+	expect := Mat4FromTranslation(Mat4Create(), []float64{0.05, 0.02, -0.03})
+	src := make([]float64, len(dst))
+	for i := 0; i < len(dst); i += 3 {
+		Vec3Subtract(src[i:i+3], dst[i:i+3], []float64{0.05, 0.02, -0.03})
+	}
+	src = append(src, 20, 20, 20, -30, 5, 5)
+
+	actual := Mat4Create()
+	Mat4ICP(actual, src, dst, &ICPOptions{MaxDistance: 1, Tolerance: 1e-10})
+	if !testSliceTol(actual, expect, 1e-4) {
+		t.Errorf("icp max distance: %v", actual)
+	}
+	Mat4ICP(actual, src, dst, &ICPOptions{TrimRatio: 0.05, Tolerance: 1e-10})
+	if !testSliceTol(actual, expect, 1e-4) {
+		t.Errorf("icp trim ratio: %v", actual)
+	}
+}
+
+func TestKdTreeNearest(t *testing.T) {
+	points, _ := ellipsoidCloud(200)
+	tree := newKdTree(points)
+	r := rand.New(rand.NewSource(2))
+	for k := 0; k < 50; k++ {
+		p := Vec3Random(Vec3Create(), 4*r.Float64())
+		best := -1
+		bestD2 := math.Inf(1)
+		for i := 0; i < len(points)/3; i++ {
+			d2 := Vec3SquaredDistance(p, points[i*3:i*3+3])
+			if d2 < bestD2 {
+				best = i
+				bestD2 = d2
+			}
+		}
+		actual, d2 := tree.nearest(p)
+		if actual != best || d2 != bestD2 {
+			t.Errorf("kd tree nearest: %v %v", actual, best)
+		}
+	}
+}
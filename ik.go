@@ -0,0 +1,319 @@
+package glmatrix
+
+import (
+	"fmt"
+	"math"
+)
+
+// The inverse kinematics solvers below work on a chain of joints given as
+// a flat array of world space vec3 positions, from the root to the end
+// effector, and an optional flat array of world space quat rotations of the
+// same joints. The positions are moved in place and every rotation is
+// updated by the shortest rotation of the bone starting at its joint, like
+// QuatRotationTo, so the results can be fed to Mat4FromRotationTranslation.
+// The end effector keeps following the last bone.
+
+// IKOptions represent the settings of the iterative inverse kinematics solvers
+type IKOptions struct {
+	// MaxIterations is the maximum number of iterations. Defaults to 20.
+	MaxIterations int
+
+	// Tolerance stops the iteration when the end effector is closer
+	// to the target than this value. Defaults to 1e-6.
+	Tolerance float64
+
+	// Pole is an optional world space point towards which the chain bends
+	Pole []float64
+
+	// Limits are optional maximum angles in radians between the bone
+	// starting at each joint and its parent bone. Zero means no limit and
+	// the root joint is never limited.
+	Limits []float64
+}
+
+// IKTwoBone solves a chain of exactly three joints analytically so that the
+// end effector reaches the target, bending the middle joint towards the pole
+// when it is given. It returns the remaining distance to the target, which
+// is zero unless the target is out of reach.
+func IKTwoBone(positions, rotations, target, pole []float64) float64 {
+	if len(positions) != 9 {
+		panic(fmt.Sprintf("Two bone chain must have 3 joints but got %v values", len(positions)))
+	}
+	before := ikBoneDirections(positions)
+	a := positions[0:3]
+	b := positions[3:6]
+	c := positions[6:9]
+	l1 := Vec3Distance(a, b)
+	l2 := Vec3Distance(b, c)
+
+	dir := Vec3Subtract(Vec3Create(), target, a)
+	d := Vec3Length(dir)
+	if d < Epsilon {
+		dir = Vec3Subtract(dir, c, a)
+		d = 0
+	}
+	Vec3Normalize(dir, dir)
+	d = math.Max(math.Min(d, l1+l2), math.Abs(l1-l2))
+
+	bend := Vec3Create()
+	if pole != nil {
+		Vec3Subtract(bend, pole, a)
+	} else {
+		Vec3Subtract(bend, b, a)
+	}
+	Vec3ScaleAndAdd(bend, bend, dir, -Vec3Dot(bend, dir))
+	if Vec3Length(bend) < Epsilon {
+		ikPerpendicular(bend, dir)
+	}
+	Vec3Normalize(bend, bend)
+
+	cosA := 1.
+	if l1 > 0 && d > 0 {
+		cosA = math.Max(-1, math.Min(1, (l1*l1+d*d-l2*l2)/(2*l1*d)))
+	}
+	sinA := math.Sqrt(1 - cosA*cosA)
+	Vec3ScaleAndAdd(b, a, dir, l1*cosA)
+	Vec3ScaleAndAdd(b, b, bend, l1*sinA)
+	Vec3ScaleAndAdd(c, a, dir, d)
+
+	ikUpdateRotations(rotations, before, positions)
+	return Vec3Distance(c, target)
+}
+
+// IKCCD solves a chain of joints by cyclic coordinate descent so that the end
+// effector reaches the target. opts may be nil to use the defaults.
+// It returns the remaining distance to the target.
+func IKCCD(positions, rotations, target []float64, opts *IKOptions) float64 {
+	maxIterations, tolerance := ikCheck(positions, rotations, opts)
+	before := ikBoneDirections(positions)
+	n := len(positions) / 3
+	end := positions[(n-1)*3 : n*3]
+	q := QuatCreate()
+	toEnd := Vec3Create()
+	toTarget := Vec3Create()
+	for iter := 0; iter < maxIterations && Vec3Distance(end, target) > tolerance; iter++ {
+		if opts != nil && opts.Pole != nil {
+			ikApplyPole(positions, opts.Pole)
+		}
+		for j := n - 2; j >= 0; j-- {
+			pivot := positions[j*3 : j*3+3]
+			Vec3Subtract(toEnd, end, pivot)
+			Vec3Subtract(toTarget, target, pivot)
+			if Vec3Length(toEnd) < Epsilon || Vec3Length(toTarget) < Epsilon {
+				continue
+			}
+			ikRotationTo(q, Vec3Normalize(toEnd, toEnd), Vec3Normalize(toTarget, toTarget))
+			ikRotateDescendants(positions, j, q)
+			ikLimitJoint(positions, j, opts)
+		}
+	}
+
+	ikUpdateRotations(rotations, before, positions)
+	return Vec3Distance(end, target)
+}
+
+// IKFABRIK solves a chain of joints by forward and backward reaching inverse
+// kinematics so that the end effector reaches the target. opts may be nil to
+// use the defaults. It returns the remaining distance to the target.
+func IKFABRIK(positions, rotations, target []float64, opts *IKOptions) float64 {
+	maxIterations, tolerance := ikCheck(positions, rotations, opts)
+	before := ikBoneDirections(positions)
+	n := len(positions) / 3
+	lengths := make([]float64, n-1)
+	total := 0.
+	for i := range lengths {
+		lengths[i] = Vec3Distance(positions[i*3:i*3+3], positions[i*3+3:i*3+6])
+		total += lengths[i]
+	}
+	root := Vec3Clone(positions[0:3])
+	end := positions[(n-1)*3 : n*3]
+	dir := Vec3Create()
+
+	if Vec3Distance(root, target) > total {
+		// out of reach: stretch towards the target
+		Vec3Normalize(dir, Vec3Subtract(dir, target, root))
+		for i := 1; i < n; i++ {
+			Vec3ScaleAndAdd(positions[i*3:i*3+3], positions[i*3-3:i*3], dir, lengths[i-1])
+		}
+		ikLimitChain(positions, lengths, opts)
+	} else {
+		for iter := 0; iter < maxIterations && Vec3Distance(end, target) > tolerance; iter++ {
+			if opts != nil && opts.Pole != nil {
+				ikApplyPole(positions, opts.Pole)
+			}
+			// backward reaching from the target
+			Vec3Copy(end, target)
+			for i := n - 2; i >= 0; i-- {
+				p := positions[i*3 : i*3+3]
+				Vec3Subtract(dir, p, positions[i*3+3:i*3+6])
+				Vec3Normalize(dir, dir)
+				Vec3ScaleAndAdd(p, positions[i*3+3:i*3+6], dir, lengths[i])
+			}
+			// forward reaching from the root
+			Vec3Copy(positions[0:3], root)
+			for i := 1; i < n; i++ {
+				p := positions[i*3 : i*3+3]
+				Vec3Subtract(dir, p, positions[i*3-3:i*3])
+				Vec3Normalize(dir, dir)
+				Vec3ScaleAndAdd(p, positions[i*3-3:i*3], dir, lengths[i-1])
+			}
+			ikLimitChain(positions, lengths, opts)
+		}
+	}
+
+	ikUpdateRotations(rotations, before, positions)
+	return Vec3Distance(end, target)
+}
+
+func ikCheck(positions, rotations []float64, opts *IKOptions) (int, float64) {
+	if len(positions)%3 != 0 || len(positions) < 6 {
+		panic(fmt.Sprintf("Chain must have at least 2 joints but got %v values", len(positions)))
+	}
+	if rotations != nil && len(rotations) != len(positions)/3*4 {
+		panic(fmt.Sprintf("Expected %v rotation values but got %v", len(positions)/3*4, len(rotations)))
+	}
+	maxIterations := 20
+	tolerance := 1e-6
+	if opts != nil {
+		if opts.MaxIterations > 0 {
+			maxIterations = opts.MaxIterations
+		}
+		if opts.Tolerance > 0 {
+			tolerance = opts.Tolerance
+		}
+	}
+	return maxIterations, tolerance
+}
+
+// ikBoneDirections returns the unit directions of the bones
+func ikBoneDirections(positions []float64) []float64 {
+	n := len(positions)/3 - 1
+	dirs := make([]float64, n*3)
+	for i := 0; i < n; i++ {
+		d := Vec3Subtract(dirs[i*3:i*3+3], positions[i*3+3:i*3+6], positions[i*3:i*3+3])
+		Vec3Normalize(d, d)
+	}
+	return dirs
+}
+
+// ikUpdateRotations rotates each joint by the rotation of its bone
+func ikUpdateRotations(rotations, before, positions []float64) {
+	if rotations == nil {
+		return
+	}
+	after := ikBoneDirections(positions)
+	q := QuatCreate()
+	n := len(before) / 3
+	for i := 0; i < n; i++ {
+		ikRotationTo(q, before[i*3:i*3+3], after[i*3:i*3+3])
+		r := rotations[i*4 : i*4+4]
+		QuatNormalize(r, QuatMultiply(r, q, r))
+	}
+	r := rotations[n*4 : n*4+4]
+	QuatNormalize(r, QuatMultiply(r, q, r))
+}
+
+// ikRotateDescendants rotates the joints after joint j about joint j
+func ikRotateDescendants(positions []float64, j int, q []float64) {
+	pivot := positions[j*3 : j*3+3]
+	n := len(positions) / 3
+	for k := j + 1; k < n; k++ {
+		p := positions[k*3 : k*3+3]
+		Vec3Subtract(p, p, pivot)
+		Vec3TransformQuat(p, p, q)
+		Vec3Add(p, p, pivot)
+	}
+}
+
+// ikLimitJoint bends the bone starting at joint j back into the cone
+// around its parent bone allowed by the limits
+func ikLimitJoint(positions []float64, j int, opts *IKOptions) {
+	if opts == nil || j == 0 || j >= len(opts.Limits) || opts.Limits[j] <= 0 {
+		return
+	}
+	parent := Vec3Subtract(Vec3Create(), positions[j*3:j*3+3], positions[j*3-3:j*3])
+	bone := Vec3Subtract(Vec3Create(), positions[j*3+3:j*3+6], positions[j*3:j*3+3])
+	angle := Vec3Angle(parent, bone)
+	if angle <= opts.Limits[j] {
+		return
+	}
+	axis := Vec3Cross(Vec3Create(), parent, bone)
+	if Vec3Length(axis) < Epsilon {
+		ikPerpendicular(axis, parent)
+	}
+	Vec3Normalize(axis, axis)
+	q := QuatSetAxisAngle(QuatCreate(), axis, opts.Limits[j]-angle)
+	ikRotateDescendants(positions, j, q)
+}
+
+func ikLimitChain(positions, lengths []float64, opts *IKOptions) {
+	if opts == nil || opts.Limits == nil {
+		return
+	}
+	for j := 1; j < len(lengths); j++ {
+		ikLimitJoint(positions, j, opts)
+	}
+}
+
+// ikApplyPole rotates every inner joint about the line through its
+// neighbours so that it lies on the side of the pole. A straight joint is
+// slightly bent towards the pole first.
+func ikApplyPole(positions, pole []float64) {
+	n := len(positions) / 3
+	axis := Vec3Create()
+	toJoint := Vec3Create()
+	toPole := Vec3Create()
+	q := QuatCreate()
+	for j := 1; j < n-1; j++ {
+		prev := positions[j*3-3 : j*3]
+		next := positions[j*3+3 : j*3+6]
+		Vec3Subtract(axis, next, prev)
+		if Vec3Length(axis) < Epsilon {
+			continue
+		}
+		Vec3Normalize(axis, axis)
+		Vec3Subtract(toJoint, positions[j*3:j*3+3], prev)
+		Vec3ScaleAndAdd(toJoint, toJoint, axis, -Vec3Dot(toJoint, axis))
+		Vec3Subtract(toPole, pole, prev)
+		Vec3ScaleAndAdd(toPole, toPole, axis, -Vec3Dot(toPole, axis))
+		if Vec3Length(toPole) < Epsilon {
+			continue
+		}
+		if Vec3Length(toJoint) < Epsilon {
+			Vec3Normalize(toPole, Vec3Cross(toPole, axis, toPole))
+			ikRotateDescendants(positions, j-1, QuatSetAxisAngle(q, toPole, 0.01))
+			continue
+		}
+		angle := Vec3Angle(toJoint, toPole)
+		if Vec3Dot(Vec3Cross(toPole, toPole, toJoint), axis) > 0 {
+			angle = -angle
+		}
+		QuatSetAxisAngle(q, axis, angle)
+		p := positions[j*3 : j*3+3]
+		Vec3Subtract(p, p, prev)
+		Vec3TransformQuat(p, p, q)
+		Vec3Add(p, p, prev)
+	}
+}
+
+// ikRotationTo sets out to the shortest rotation from one unit vector to
+// another. Unlike QuatRotationTo it keeps tiny rotations, which the
+// iterative solvers need to converge.
+func ikRotationTo(out, a, b []float64) []float64 {
+	axis := Vec3Cross(Vec3Create(), a, b)
+	s := Vec3Length(axis)
+	if s < 1e-12 {
+		return QuatRotationTo(out, a, b)
+	}
+	return QuatSetAxisAngle(out, Vec3Scale(axis, axis, 1/s), math.Atan2(s, Vec3Dot(a, b)))
+}
+
+// ikPerpendicular sets out to an arbitrary vector perpendicular to v
+func ikPerpendicular(out, v []float64) []float64 {
+	if math.Abs(v[0]) < 0.9 {
+		Vec3Cross(out, v, []float64{1, 0, 0})
+	} else {
+		Vec3Cross(out, v, []float64{0, 1, 0})
+	}
+	return out
+}
@@ -0,0 +1,121 @@
+package glmatrix
+
+import (
+	"math"
+	"testing"
+)
+
+func ikChain(n int) ([]float64, []float64) {
+	positions := make([]float64, 0, n*3)
+	rotations := make([]float64, 0, n*4)
+	for i := 0; i < n; i++ {
+		positions = append(positions, float64(i), 0, 0)
+		rotations = append(rotations, 0, 0, 0, 1)
+	}
+	return positions, rotations
+}
+
+func testIKChain(t *testing.T, name string, positions, rotations []float64) {
+	n := len(positions) / 3
+	for i := 0; i < n-1; i++ {
+		bone := Vec3Subtract(Vec3Create(), positions[i*3+3:i*3+6], positions[i*3:i*3+3])
+		if !equals(Vec3Length(bone), 1) {
+			t.Errorf("%v bone length %v: %v", name, i, Vec3Length(bone))
+		}
+		rest := Vec3TransformQuat(Vec3Create(), []float64{1, 0, 0}, rotations[i*4:i*4+4])
+		if !testSliceTol(rest, bone, 1e-6) {
+			t.Errorf("%v bone rotation %v: %v %v", name, i, rest, bone)
+		}
+	}
+	if !testSlice(positions[0:3], []float64{0, 0, 0}) {
+		t.Errorf("%v root moved: %v", name, positions[0:3])
+	}
+}
+
+func TestIKTwoBone(t *testing.T) {
+	positions, rotations := ikChain(3)
+	target := []float64{1, 1, 0}
+	pole := []float64{0, 0, 5}
+	d := IKTwoBone(positions, rotations, target, pole)
+	if !equals(d, 0) || !testSlice(positions[6:9], target) {
+		t.Errorf("two bone: %v %v", d, positions)
+	}
+	if positions[5] <= 0 {
+		t.Errorf("two bone pole: %v", positions)
+	}
+	testIKChain(t, "two bone", positions, rotations)
+
+	d = IKTwoBone(positions, rotations, []float64{0, 3, 0}, nil)
+	if !equals(d, 1) || !testSlice(positions[6:9], []float64{0, 2, 0}) {
+		t.Errorf("two bone unreachable: %v %v", d, positions)
+	}
+	testIKChain(t, "two bone unreachable", positions, rotations)
+}
+
+func TestIKCCD(t *testing.T) {
+	positions, rotations := ikChain(4)
+	target := []float64{1, 2, 0.5}
+	d := IKCCD(positions, rotations, target, &IKOptions{MaxIterations: 100})
+	if d > 1e-6 {
+		t.Errorf("ccd: %v %v", d, positions)
+	}
+	testIKChain(t, "ccd", positions, rotations)
+}
+
+func TestIKFABRIK(t *testing.T) {
+	positions, rotations := ikChain(4)
+	target := []float64{1, 2, 0.5}
+	d := IKFABRIK(positions, rotations, target, &IKOptions{MaxIterations: 100})
+	if d > 1e-6 {
+		t.Errorf("fabrik: %v %v", d, positions)
+	}
+	testIKChain(t, "fabrik", positions, rotations)
+
+	positions, rotations = ikChain(4)
+	d = IKFABRIK(positions, rotations, []float64{0, 0, 5}, nil)
+	if !equals(d, 2) || !testSlice(positions[9:12], []float64{0, 0, 3}) {
+		t.Errorf("fabrik unreachable: %v %v", d, positions)
+	}
+	testIKChain(t, "fabrik unreachable", positions, rotations)
+}
+
+func TestIKPole(t *testing.T) {
+	for _, name := range []string{"ccd", "fabrik"} {
+		positions, rotations := ikChain(3)
+		opts := &IKOptions{MaxIterations: 100, Pole: []float64{1, 0, -5}}
+		var d float64
+		if name == "ccd" {
+			d = IKCCD(positions, rotations, []float64{1.5, 0, 0}, opts)
+		} else {
+			d = IKFABRIK(positions, rotations, []float64{1.5, 0, 0}, opts)
+		}
+		if d > 1e-6 {
+			t.Errorf("%v pole: %v %v", name, d, positions)
+		}
+		if positions[5] >= 0 || math.Abs(positions[4]) > 1e-6 {
+			t.Errorf("%v pole side: %v", name, positions)
+		}
+		testIKChain(t, name+" pole", positions, rotations)
+	}
+}
+
+func TestIKLimits(t *testing.T) {
+	limit := 0.4
+	for _, name := range []string{"ccd", "fabrik"} {
+		positions, rotations := ikChain(5)
+		opts := &IKOptions{MaxIterations: 200, Limits: []float64{0, limit, limit, limit}}
+		if name == "ccd" {
+			IKCCD(positions, rotations, []float64{0, 2, 0}, opts)
+		} else {
+			IKFABRIK(positions, rotations, []float64{0, 2, 0}, opts)
+		}
+		for j := 1; j < 4; j++ {
+			parent := Vec3Subtract(Vec3Create(), positions[j*3:j*3+3], positions[j*3-3:j*3])
+			bone := Vec3Subtract(Vec3Create(), positions[j*3+3:j*3+6], positions[j*3:j*3+3])
+			if angle := Vec3Angle(parent, bone); angle > limit+1e-6 {
+				t.Errorf("%v limit %v: %v", name, j, angle)
+			}
+		}
+		testIKChain(t, name+" limits", positions, rotations)
+	}
+}
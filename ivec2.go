@@ -0,0 +1,189 @@
+package glmatrix
+
+import (
+	"fmt"
+	"math"
+)
+
+// NewIVec2 creates a new, empty IVec2
+func NewIVec2() []int {
+	return []int{0, 0}
+}
+
+// IVec2Create creates a new, empty IVec2
+func IVec2Create() []int {
+	return NewIVec2()
+}
+
+// IVec2Clone creates a new IVec2 initialized with values from an existing vector
+func IVec2Clone(a []int) []int {
+	return []int{a[0], a[1]}
+}
+
+// IVec2FromValues creates a new IVec2 initialized with the given values
+func IVec2FromValues(x, y int) []int {
+	return []int{x, y}
+}
+
+// IVec2Copy copy the values from one IVec2 to another
+func IVec2Copy(out, a []int) []int {
+	out[0] = a[0]
+	out[1] = a[1]
+	return out
+}
+
+// IVec2Set set the components of an IVec2 to the given values
+func IVec2Set(out []int, x, y int) []int {
+	out[0] = x
+	out[1] = y
+	return out
+}
+
+// IVec2Add adds two IVec2's
+func IVec2Add(out, a, b []int) []int {
+	out[0] = a[0] + b[0]
+	out[1] = a[1] + b[1]
+	return out
+}
+
+// IVec2Subtract subtracts vector b from vector a
+func IVec2Subtract(out, a, b []int) []int {
+	out[0] = a[0] - b[0]
+	out[1] = a[1] - b[1]
+	return out
+}
+
+// IVec2Multiply multiplies two IVec2's
+func IVec2Multiply(out, a, b []int) []int {
+	out[0] = a[0] * b[0]
+	out[1] = a[1] * b[1]
+	return out
+}
+
+// IVec2Divide divides two IVec2's rounding toward negative infinity,
+// so that negative coordinates fall into the right chunk of a grid
+func IVec2Divide(out, a, b []int) []int {
+	out[0] = floorDiv(a[0], b[0])
+	out[1] = floorDiv(a[1], b[1])
+	return out
+}
+
+// IVec2Scale scales an IVec2 by a scalar number
+func IVec2Scale(out, a []int, scale int) []int {
+	out[0] = a[0] * scale
+	out[1] = a[1] * scale
+	return out
+}
+
+// IVec2Min returns the minimum of two IVec2's
+func IVec2Min(out, a, b []int) []int {
+	out[0] = a[0]
+	if b[0] < a[0] {
+		out[0] = b[0]
+	}
+	out[1] = a[1]
+	if b[1] < a[1] {
+		out[1] = b[1]
+	}
+	return out
+}
+
+// IVec2Max returns the maximum of two IVec2's
+func IVec2Max(out, a, b []int) []int {
+	out[0] = a[0]
+	if b[0] > a[0] {
+		out[0] = b[0]
+	}
+	out[1] = a[1]
+	if b[1] > a[1] {
+		out[1] = b[1]
+	}
+	return out
+}
+
+// IVec2Negate negates the components of an IVec2
+func IVec2Negate(out, a []int) []int {
+	out[0] = -a[0]
+	out[1] = -a[1]
+	return out
+}
+
+// IVec2Abs returns the absolute values of the components of an IVec2
+func IVec2Abs(out, a []int) []int {
+	out[0] = absInt(a[0])
+	out[1] = absInt(a[1])
+	return out
+}
+
+// IVec2Dot calculates the dot product of two IVec2's
+func IVec2Dot(a, b []int) int {
+	return a[0]*b[0] + a[1]*b[1]
+}
+
+// IVec2ManhattanDistance calculates the manhattan distance between two IVec2's
+func IVec2ManhattanDistance(a, b []int) int {
+	return absInt(b[0]-a[0]) + absInt(b[1]-a[1])
+}
+
+// IVec2ChebyshevDistance calculates the chebyshev distance between two IVec2's
+func IVec2ChebyshevDistance(a, b []int) int {
+	d := absInt(b[0] - a[0])
+	if y := absInt(b[1] - a[1]); y > d {
+		d = y
+	}
+	return d
+}
+
+// IVec2FromVec2Floor set an IVec2 to the floor of the components of a vec2
+func IVec2FromVec2Floor(out []int, a []float64) []int {
+	out[0] = int(math.Floor(a[0]))
+	out[1] = int(math.Floor(a[1]))
+	return out
+}
+
+// IVec2ToVec2 set a vec2 to the components of an IVec2
+func IVec2ToVec2(out []float64, a []int) []float64 {
+	out[0] = float64(a[0])
+	out[1] = float64(a[1])
+	return out
+}
+
+// IVec2Zero set the components of an IVec2 to zero
+func IVec2Zero(out []int) []int {
+	out[0] = 0
+	out[1] = 0
+	return out
+}
+
+// IVec2Hash returns a hash of an IVec2 suitable for keying cells of a spatial hash
+func IVec2Hash(a []int) uint64 {
+	return ivecHash(a[0:2])
+}
+
+// IVec2Str returns a string representation of a vector
+func IVec2Str(a []int) string {
+	return fmt.Sprintf("ivec2(%v, %v)", a[0], a[1])
+}
+
+// IVec2Equals returns whether or not the vectors have the same elements in the same position
+func IVec2Equals(a, b []int) bool {
+	return a[0] == b[0] && a[1] == b[1]
+}
+
+// IVec2GridTraversal walks the tiles of a grid with the given cell size pierced
+// by a ray with the Amanatides-Woo algorithm. fn is called with the index of
+// each tile in order and the distance along the ray at which the ray enters it,
+// zero for the tile containing the origin, until fn returns false or the
+// distance exceeds maxDistance. The cell passed to fn is reused between calls.
+func IVec2GridTraversal(origin, direction []float64, cellSize, maxDistance float64, fn func(cell []int, t float64) bool) {
+	gridTraversal(origin, direction, 2, cellSize, maxDistance, fn)
+}
+
+// IVec2Sub alias for IVec2Subtract
+var IVec2Sub = IVec2Subtract
+
+// IVec2Mul alias for IVec2Multiply
+var IVec2Mul = IVec2Multiply
+
+// IVec2Div alias for IVec2Divide
+var IVec2Div = IVec2Divide
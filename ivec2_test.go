@@ -0,0 +1,93 @@
+package glmatrix
+
+import (
+	"math"
+	"testing"
+)
+
+var ivec2A = []int{1, 2}
+var ivec2B = []int{-3, 4}
+
+func TestIVec2Add(t *testing.T) {
+	actual := IVec2Add(IVec2Create(), ivec2A, ivec2B)
+	if !testIntSlice(actual, []int{-2, 6}) {
+		t.Errorf("add: %v", actual)
+	}
+	actual = IVec2Subtract(IVec2Create(), ivec2A, ivec2B)
+	if !testIntSlice(actual, []int{4, -2}) {
+		t.Errorf("subtract: %v", actual)
+	}
+}
+
+func TestIVec2Divide(t *testing.T) {
+	actual := IVec2Divide(IVec2Create(), []int{-5, 5}, []int{4, -4})
+	if !testIntSlice(actual, []int{-2, -2}) {
+		t.Errorf("divide: %v", actual)
+	}
+}
+
+func TestIVec2MinMax(t *testing.T) {
+	actual := IVec2Min(IVec2Create(), ivec2A, ivec2B)
+	if !testIntSlice(actual, []int{-3, 2}) {
+		t.Errorf("min: %v", actual)
+	}
+	actual = IVec2Max(IVec2Create(), ivec2A, ivec2B)
+	if !testIntSlice(actual, []int{1, 4}) {
+		t.Errorf("max: %v", actual)
+	}
+}
+
+func TestIVec2Distance(t *testing.T) {
+	if actual := IVec2ManhattanDistance(ivec2A, ivec2B); actual != 6 {
+		t.Errorf("manhattan distance: %v", actual)
+	}
+	if actual := IVec2ChebyshevDistance(ivec2A, ivec2B); actual != 4 {
+		t.Errorf("chebyshev distance: %v", actual)
+	}
+}
+
+func TestIVec2FromVec2Floor(t *testing.T) {
+	actual := IVec2FromVec2Floor(IVec2Create(), []float64{-0.1, 2.9})
+	if !testIntSlice(actual, []int{-1, 2}) {
+		t.Errorf("from vec2 floor: %v", actual)
+	}
+}
+
+func TestIVec2Hash(t *testing.T) {
+	if IVec2Hash([]int{1, 2}) == IVec2Hash([]int{2, 1}) {
+		t.Errorf("hash: symmetric")
+	}
+	if IVec2Hash([]int{0, 0}) == IVec3Hash([]int{0, 0, 0}) {
+		t.Errorf("hash: ignores dimension")
+	}
+}
+
+func TestIVec2GridTraversal(t *testing.T) {
+	var cells []int
+	var ts []float64
+	IVec2GridTraversal([]float64{0.5, 0.25}, []float64{1, 1}, 1, 10, func(cell []int, t float64) bool {
+		cells = append(cells, cell...)
+		ts = append(ts, t)
+		return len(ts) < 4
+	})
+	if !testIntSlice(cells, []int{0, 0, 1, 0, 1, 1, 2, 1}) {
+		t.Errorf("grid traversal cells: %v", cells)
+	}
+	if !testSlice(ts, []float64{0, 0.5 * math.Sqrt2, 0.75 * math.Sqrt2, 1.5 * math.Sqrt2}) {
+		t.Errorf("grid traversal distances: %v", ts)
+	}
+}
+
+func TestIVec2GridTraversalZeroDirection(t *testing.T) {
+	count := 0
+	IVec2GridTraversal([]float64{-0.5, 0.5}, []float64{0, 0}, 1, 10, func(cell []int, d float64) bool {
+		if !testIntSlice(cell, []int{-1, 0}) || d != 0 {
+			t.Errorf("grid traversal: %v %v", cell, d)
+		}
+		count++
+		return true
+	})
+	if count != 1 {
+		t.Errorf("grid traversal zero direction: %v cells", count)
+	}
+}
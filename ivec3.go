@@ -0,0 +1,212 @@
+package glmatrix
+
+import (
+	"fmt"
+	"math"
+)
+
+// NewIVec3 creates a new, empty IVec3
+func NewIVec3() []int {
+	return []int{0, 0, 0}
+}
+
+// IVec3Create creates a new, empty IVec3
+func IVec3Create() []int {
+	return NewIVec3()
+}
+
+// IVec3Clone creates a new IVec3 initialized with values from an existing vector
+func IVec3Clone(a []int) []int {
+	return []int{a[0], a[1], a[2]}
+}
+
+// IVec3FromValues creates a new IVec3 initialized with the given values
+func IVec3FromValues(x, y, z int) []int {
+	return []int{x, y, z}
+}
+
+// IVec3Copy copy the values from one IVec3 to another
+func IVec3Copy(out, a []int) []int {
+	out[0] = a[0]
+	out[1] = a[1]
+	out[2] = a[2]
+	return out
+}
+
+// IVec3Set set the components of an IVec3 to the given values
+func IVec3Set(out []int, x, y, z int) []int {
+	out[0] = x
+	out[1] = y
+	out[2] = z
+	return out
+}
+
+// IVec3Add adds two IVec3's
+func IVec3Add(out, a, b []int) []int {
+	out[0] = a[0] + b[0]
+	out[1] = a[1] + b[1]
+	out[2] = a[2] + b[2]
+	return out
+}
+
+// IVec3Subtract subtracts vector b from vector a
+func IVec3Subtract(out, a, b []int) []int {
+	out[0] = a[0] - b[0]
+	out[1] = a[1] - b[1]
+	out[2] = a[2] - b[2]
+	return out
+}
+
+// IVec3Multiply multiplies two IVec3's
+func IVec3Multiply(out, a, b []int) []int {
+	out[0] = a[0] * b[0]
+	out[1] = a[1] * b[1]
+	out[2] = a[2] * b[2]
+	return out
+}
+
+// IVec3Divide divides two IVec3's rounding toward negative infinity,
+// so that negative coordinates fall into the right chunk of a grid
+func IVec3Divide(out, a, b []int) []int {
+	out[0] = floorDiv(a[0], b[0])
+	out[1] = floorDiv(a[1], b[1])
+	out[2] = floorDiv(a[2], b[2])
+	return out
+}
+
+// IVec3Scale scales an IVec3 by a scalar number
+func IVec3Scale(out, a []int, scale int) []int {
+	out[0] = a[0] * scale
+	out[1] = a[1] * scale
+	out[2] = a[2] * scale
+	return out
+}
+
+// IVec3Min returns the minimum of two IVec3's
+func IVec3Min(out, a, b []int) []int {
+	out[0] = a[0]
+	if b[0] < a[0] {
+		out[0] = b[0]
+	}
+	out[1] = a[1]
+	if b[1] < a[1] {
+		out[1] = b[1]
+	}
+	out[2] = a[2]
+	if b[2] < a[2] {
+		out[2] = b[2]
+	}
+	return out
+}
+
+// IVec3Max returns the maximum of two IVec3's
+func IVec3Max(out, a, b []int) []int {
+	out[0] = a[0]
+	if b[0] > a[0] {
+		out[0] = b[0]
+	}
+	out[1] = a[1]
+	if b[1] > a[1] {
+		out[1] = b[1]
+	}
+	out[2] = a[2]
+	if b[2] > a[2] {
+		out[2] = b[2]
+	}
+	return out
+}
+
+// IVec3Negate negates the components of an IVec3
+func IVec3Negate(out, a []int) []int {
+	out[0] = -a[0]
+	out[1] = -a[1]
+	out[2] = -a[2]
+	return out
+}
+
+// IVec3Abs returns the absolute values of the components of an IVec3
+func IVec3Abs(out, a []int) []int {
+	out[0] = absInt(a[0])
+	out[1] = absInt(a[1])
+	out[2] = absInt(a[2])
+	return out
+}
+
+// IVec3Dot calculates the dot product of two IVec3's
+func IVec3Dot(a, b []int) int {
+	return a[0]*b[0] + a[1]*b[1] + a[2]*b[2]
+}
+
+// IVec3ManhattanDistance calculates the manhattan distance between two IVec3's
+func IVec3ManhattanDistance(a, b []int) int {
+	return absInt(b[0]-a[0]) + absInt(b[1]-a[1]) + absInt(b[2]-a[2])
+}
+
+// IVec3ChebyshevDistance calculates the chebyshev distance between two IVec3's
+func IVec3ChebyshevDistance(a, b []int) int {
+	d := absInt(b[0] - a[0])
+	if y := absInt(b[1] - a[1]); y > d {
+		d = y
+	}
+	if z := absInt(b[2] - a[2]); z > d {
+		d = z
+	}
+	return d
+}
+
+// IVec3FromVec3Floor set an IVec3 to the floor of the components of a Vec3
+func IVec3FromVec3Floor(out []int, a []float64) []int {
+	out[0] = int(math.Floor(a[0]))
+	out[1] = int(math.Floor(a[1]))
+	out[2] = int(math.Floor(a[2]))
+	return out
+}
+
+// IVec3ToVec3 set a Vec3 to the components of an IVec3
+func IVec3ToVec3(out []float64, a []int) []float64 {
+	out[0] = float64(a[0])
+	out[1] = float64(a[1])
+	out[2] = float64(a[2])
+	return out
+}
+
+// IVec3Zero set the components of an IVec3 to zero
+func IVec3Zero(out []int) []int {
+	out[0] = 0
+	out[1] = 0
+	out[2] = 0
+	return out
+}
+
+// IVec3Hash returns a hash of an IVec3 suitable for keying cells of a spatial hash
+func IVec3Hash(a []int) uint64 {
+	return ivecHash(a[0:3])
+}
+
+// IVec3Str returns a string representation of a vector
+func IVec3Str(a []int) string {
+	return fmt.Sprintf("ivec3(%v, %v, %v)", a[0], a[1], a[2])
+}
+
+// IVec3Equals returns whether or not the vectors have the same elements in the same position
+func IVec3Equals(a, b []int) bool {
+	return a[0] == b[0] && a[1] == b[1] && a[2] == b[2]
+}
+
+// IVec3VoxelTraversal walks the voxels of a grid with the given cell size pierced
+// by a ray with the Amanatides-Woo algorithm. fn is called with the index of
+// each voxel in order and the distance along the ray at which the ray enters it,
+// zero for the voxel containing the origin, until fn returns false or the
+// distance exceeds maxDistance. The cell passed to fn is reused between calls.
+func IVec3VoxelTraversal(origin, direction []float64, cellSize, maxDistance float64, fn func(cell []int, t float64) bool) {
+	gridTraversal(origin, direction, 3, cellSize, maxDistance, fn)
+}
+
+// IVec3Sub alias for IVec3Subtract
+var IVec3Sub = IVec3Subtract
+
+// IVec3Mul alias for IVec3Multiply
+var IVec3Mul = IVec3Multiply
+
+// IVec3Div alias for IVec3Divide
+var IVec3Div = IVec3Divide
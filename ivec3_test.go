@@ -0,0 +1,152 @@
+package glmatrix
+
+import (
+	"math"
+	"testing"
+)
+
+var ivec3A = []int{1, 2, 3}
+var ivec3B = []int{4, -5, 6}
+
+func testIntSlice(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestIVec3Add(t *testing.T) {
+	actual := IVec3Add(IVec3Create(), ivec3A, ivec3B)
+	if !testIntSlice(actual, []int{5, -3, 9}) {
+		t.Errorf("add: %v", actual)
+	}
+}
+
+func TestIVec3Subtract(t *testing.T) {
+	actual := IVec3Subtract(IVec3Create(), ivec3A, ivec3B)
+	if !testIntSlice(actual, []int{-3, 7, -3}) {
+		t.Errorf("subtract: %v", actual)
+	}
+}
+
+func TestIVec3Multiply(t *testing.T) {
+	actual := IVec3Multiply(IVec3Create(), ivec3A, ivec3B)
+	if !testIntSlice(actual, []int{4, -10, 18}) {
+		t.Errorf("multiply: %v", actual)
+	}
+	actual = IVec3Scale(IVec3Create(), ivec3A, -2)
+	if !testIntSlice(actual, []int{-2, -4, -6}) {
+		t.Errorf("scale: %v", actual)
+	}
+}
+
+func TestIVec3Divide(t *testing.T) {
+	actual := IVec3Divide(IVec3Create(), []int{-1, 17, -16}, []int{16, 16, 16})
+	if !testIntSlice(actual, []int{-1, 1, -1}) {
+		t.Errorf("divide: %v", actual)
+	}
+}
+
+func TestIVec3MinMax(t *testing.T) {
+	actual := IVec3Min(IVec3Create(), ivec3A, ivec3B)
+	if !testIntSlice(actual, []int{1, -5, 3}) {
+		t.Errorf("min: %v", actual)
+	}
+	actual = IVec3Max(IVec3Create(), ivec3A, ivec3B)
+	if !testIntSlice(actual, []int{4, 2, 6}) {
+		t.Errorf("max: %v", actual)
+	}
+}
+
+func TestIVec3Abs(t *testing.T) {
+	actual := IVec3Abs(IVec3Create(), IVec3Negate(IVec3Create(), ivec3B))
+	if !testIntSlice(actual, []int{4, 5, 6}) {
+		t.Errorf("abs: %v", actual)
+	}
+}
+
+func TestIVec3Distance(t *testing.T) {
+	if actual := IVec3Dot(ivec3A, ivec3B); actual != 12 {
+		t.Errorf("dot: %v", actual)
+	}
+	if actual := IVec3ManhattanDistance(ivec3A, ivec3B); actual != 13 {
+		t.Errorf("manhattan distance: %v", actual)
+	}
+	if actual := IVec3ChebyshevDistance(ivec3A, ivec3B); actual != 7 {
+		t.Errorf("chebyshev distance: %v", actual)
+	}
+}
+
+func TestIVec3FromVec3Floor(t *testing.T) {
+	actual := IVec3FromVec3Floor(IVec3Create(), []float64{1.5, -0.5, -2})
+	if !testIntSlice(actual, []int{1, -1, -2}) {
+		t.Errorf("from vec3 floor: %v", actual)
+	}
+	v := IVec3ToVec3(Vec3Create(), actual)
+	if !testSlice(v, []float64{1, -1, -2}) {
+		t.Errorf("to vec3: %v", v)
+	}
+}
+
+func TestIVec3Hash(t *testing.T) {
+	if IVec3Hash(ivec3A) != IVec3Hash(IVec3Clone(ivec3A)) {
+		t.Errorf("hash: not deterministic")
+	}
+	seen := map[uint64]bool{}
+	for x := -8; x < 8; x++ {
+		for y := -8; y < 8; y++ {
+			for z := -8; z < 8; z++ {
+				seen[IVec3Hash([]int{x, y, z})] = true
+			}
+		}
+	}
+	if len(seen) != 16*16*16 {
+		t.Errorf("hash: %v collisions", 16*16*16-len(seen))
+	}
+}
+
+func TestIVec3Str(t *testing.T) {
+	actual := IVec3Str(ivec3B)
+	if actual != "ivec3(4, -5, 6)" {
+		t.Errorf("str: %v", actual)
+	}
+}
+
+func TestIVec3VoxelTraversal(t *testing.T) {
+	var cells []int
+	var ts []float64
+	IVec3VoxelTraversal([]float64{0.5, 0.5, 0.5}, []float64{2, 0, 0}, 1, 3, func(cell []int, t float64) bool {
+		cells = append(cells, cell...)
+		ts = append(ts, t)
+		return true
+	})
+	if !testIntSlice(cells, []int{0, 0, 0, 1, 0, 0, 2, 0, 0, 3, 0, 0}) {
+		t.Errorf("voxel traversal cells: %v", cells)
+	}
+	if !testSlice(ts, []float64{0, 0.5, 1.5, 2.5}) {
+		t.Errorf("voxel traversal distances: %v", ts)
+	}
+}
+
+func TestIVec3VoxelTraversalDiagonal(t *testing.T) {
+	var cells []int
+	var ts []float64
+	IVec3VoxelTraversal([]float64{-0.4, 0.25, 0.5}, []float64{-1, 1, 0}, 0.5, 1, func(cell []int, t float64) bool {
+		cells = append(cells, cell...)
+		ts = append(ts, t)
+		return len(ts) < 3
+	})
+	// the ray leaves the first cell through x at 0.1 * sqrt(2)
+	// and the next one through y at 0.25 * sqrt(2)
+	if !testIntSlice(cells, []int{-1, 0, 1, -2, 0, 1, -2, 1, 1}) {
+		t.Errorf("voxel traversal cells: %v", cells)
+	}
+	if !testSlice(ts, []float64{0, 0.1 * math.Sqrt2, 0.25 * math.Sqrt2}) {
+		t.Errorf("voxel traversal distances: %v", ts)
+	}
+}
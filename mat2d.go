@@ -174,6 +174,42 @@ func Mat2dTranslate(out, a, v []float64) []float64 {
 	return out
 }
 
+// Mat2dSkewX skews the mat2d along the x axis by the given angle
+func Mat2dSkewX(out, a []float64, rad float64) []float64 {
+	a0 := a[0]
+	a1 := a[1]
+	a2 := a[2]
+	a3 := a[3]
+	a4 := a[4]
+	a5 := a[5]
+	t := math.Tan(rad)
+	out[0] = a0
+	out[1] = a1
+	out[2] = a0*t + a2
+	out[3] = a1*t + a3
+	out[4] = a4
+	out[5] = a5
+	return out
+}
+
+// Mat2dSkewY skews the mat2d along the y axis by the given angle
+func Mat2dSkewY(out, a []float64, rad float64) []float64 {
+	a0 := a[0]
+	a1 := a[1]
+	a2 := a[2]
+	a3 := a[3]
+	a4 := a[4]
+	a5 := a[5]
+	t := math.Tan(rad)
+	out[0] = a0 + a2*t
+	out[1] = a1 + a3*t
+	out[2] = a2
+	out[3] = a3
+	out[4] = a4
+	out[5] = a5
+	return out
+}
+
 // Mat2dFromRotation creates a matrix from a given angle
 // This is equivalent to (but much faster than):
 //
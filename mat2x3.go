@@ -0,0 +1,161 @@
+package glmatrix
+
+import "fmt"
+
+// A mat2x3 is a 2D affine transformation stored in row-major order, i.e. each
+// of the two rows is a vec3 whose last component is the translation:
+// [a, c, tx, b, d, ty]. It holds the same values as a mat2d, which stores
+// them in column-major order.
+
+// Mat2x3Create creates a new identity mat2x3
+func Mat2x3Create() []float64 {
+	return []float64{
+		1, 0, 0,
+		0, 1, 0,
+	}
+}
+
+// Mat2x3Clone creates a new mat2x3 initialized with values from an existing matrix
+func Mat2x3Clone(a []float64) []float64 {
+	return []float64{
+		a[0], a[1], a[2],
+		a[3], a[4], a[5],
+	}
+}
+
+// Mat2x3Copy copy the values from one mat2x3 to another
+func Mat2x3Copy(out, a []float64) []float64 {
+	out[0] = a[0]
+	out[1] = a[1]
+	out[2] = a[2]
+	out[3] = a[3]
+	out[4] = a[4]
+	out[5] = a[5]
+	return out
+}
+
+// Mat2x3Identity set a mat2x3 to the identity matrix
+func Mat2x3Identity(out []float64) []float64 {
+	out[0] = 1
+	out[1] = 0
+	out[2] = 0
+	out[3] = 0
+	out[4] = 1
+	out[5] = 0
+	return out
+}
+
+// Mat2x3FromMat2d converts a mat2d into a mat2x3
+func Mat2x3FromMat2d(out, a []float64) []float64 {
+	aa := a[0]
+	ab := a[1]
+	ac := a[2]
+	ad := a[3]
+	atx := a[4]
+	aty := a[5]
+	out[0] = aa
+	out[1] = ac
+	out[2] = atx
+	out[3] = ab
+	out[4] = ad
+	out[5] = aty
+	return out
+}
+
+// Mat2dFromMat2x3 converts a mat2x3 into a mat2d
+func Mat2dFromMat2x3(out, a []float64) []float64 {
+	aa := a[0]
+	ac := a[1]
+	atx := a[2]
+	ab := a[3]
+	ad := a[4]
+	aty := a[5]
+	out[0] = aa
+	out[1] = ab
+	out[2] = ac
+	out[3] = ad
+	out[4] = atx
+	out[5] = aty
+	return out
+}
+
+// Mat2x3Multiply multiplies two mat2x3's as affine transformations
+func Mat2x3Multiply(out, a, b []float64) []float64 {
+	a0 := a[0]
+	a1 := a[1]
+	a2 := a[2]
+	a3 := a[3]
+	a4 := a[4]
+	a5 := a[5]
+	b0 := b[0]
+	b1 := b[1]
+	b2 := b[2]
+	b3 := b[3]
+	b4 := b[4]
+	b5 := b[5]
+	out[0] = a0*b0 + a1*b3
+	out[1] = a0*b1 + a1*b4
+	out[2] = a0*b2 + a1*b5 + a2
+	out[3] = a3*b0 + a4*b3
+	out[4] = a3*b1 + a4*b4
+	out[5] = a3*b2 + a4*b5 + a5
+	return out
+}
+
+// Mat2x3Determinant calculates the determinant of the linear part of a mat2x3
+func Mat2x3Determinant(a []float64) float64 {
+	return a[0]*a[4] - a[1]*a[3]
+}
+
+// Mat2x3Invert inverts a mat2x3 as an affine transformation.
+// It returns nil if the matrix is singular.
+func Mat2x3Invert(out, a []float64) []float64 {
+	aa := a[0]
+	ac := a[1]
+	atx := a[2]
+	ab := a[3]
+	ad := a[4]
+	aty := a[5]
+
+	det := aa*ad - ab*ac
+	if det == 0. {
+		return nil
+	}
+	det = 1.0 / det
+
+	out[0] = ad * det
+	out[1] = -ac * det
+	out[2] = (ac*aty - ad*atx) * det
+	out[3] = -ab * det
+	out[4] = aa * det
+	out[5] = (ab*atx - aa*aty) * det
+	return out
+}
+
+// Vec2TransformMat2x3 transforms the vec2 with a mat2x3
+func Vec2TransformMat2x3(out, a, m []float64) []float64 {
+	x := a[0]
+	y := a[1]
+	out[0] = m[0]*x + m[1]*y + m[2]
+	out[1] = m[3]*x + m[4]*y + m[5]
+	return out
+}
+
+// Mat2x3Str returns a string representation of a mat2x3
+func Mat2x3Str(a []float64) string {
+	return fmt.Sprintf("mat2x3(%v, %v, %v, %v, %v, %v)", a[0], a[1], a[2], a[3], a[4], a[5])
+}
+
+// Mat2x3ExactEquals returns whether or not the matrices have exactly the same elements in the same position (when compared with ===)
+func Mat2x3ExactEquals(a, b []float64) bool {
+	return a[0] == b[0] && a[1] == b[1] && a[2] == b[2] && a[3] == b[3] && a[4] == b[4] && a[5] == b[5]
+}
+
+// Mat2x3Equals returns whether or not the matrices have approximately the same elements in the same position.
+func Mat2x3Equals(a, b []float64) bool {
+	return equals(a[0], b[0]) && equals(a[1], b[1]) && equals(a[2], b[2]) &&
+		equals(a[3], b[3]) && equals(a[4], b[4]) && equals(a[5], b[5])
+}
+
+// Mat2x3Mul alias for Mat2x3Multiply
+var Mat2x3Mul = Mat2x3Multiply
@@ -0,0 +1,62 @@
+package glmatrix
+
+import "testing"
+
+var mat2x3A = []float64{
+	1, 3, 5,
+	2, 4, 6,
+}
+
+func TestMat2x3FromMat2d(t *testing.T) {
+	m := Mat2dFromValues(1, 2, 3, 4, 5, 6)
+	actual := Mat2x3FromMat2d(Mat2x3Create(), m)
+	if !testSlice(actual, mat2x3A) {
+		t.Errorf("from mat2d: %v", actual)
+	}
+	back := Mat2dFromMat2x3(Mat2dCreate(), actual)
+	if !testSlice(back, m) {
+		t.Errorf("to mat2d: %v", back)
+	}
+	if !testSlice(Mat2dFromMat2x3(Mat2dCreate(), Mat2x3Create()), Mat2dCreate()) {
+		t.Errorf("identity")
+	}
+}
+
+func TestMat2x3Multiply(t *testing.T) {
+	a := Mat2dFromValues(1, 2, 3, 4, 5, 6)
+	b := Mat2dFromValues(7, 8, 9, 10, 11, 12)
+	expect := Mat2x3FromMat2d(Mat2x3Create(), Mat2dMultiply(Mat2dCreate(), a, b))
+	actual := Mat2x3Multiply(Mat2x3Create(), Mat2x3FromMat2d(Mat2x3Create(), a), Mat2x3FromMat2d(Mat2x3Create(), b))
+	if !testSlice(actual, expect) {
+		t.Errorf("multiply: %v", actual)
+	}
+}
+
+func TestMat2x3Invert(t *testing.T) {
+	expect := Mat2x3FromMat2d(Mat2x3Create(), Mat2dInvert(Mat2dCreate(), Mat2dFromValues(1, 2, 3, 4, 5, 6)))
+	actual := Mat2x3Invert(Mat2x3Create(), mat2x3A)
+	if !testSlice(actual, expect) {
+		t.Errorf("invert: %v", actual)
+	}
+	if actual := Mat2x3Determinant(mat2x3A); actual != -2 {
+		t.Errorf("determinant: %v", actual)
+	}
+	if actual := Mat2x3Invert(Mat2x3Create(), []float64{1, 2, 0, 2, 4, 0}); actual != nil {
+		t.Errorf("invert singular: %v", actual)
+	}
+}
+
+func TestVec2TransformMat2x3(t *testing.T) {
+	expect := Vec2TransformMat2d(Vec2Create(), vec2A, Mat2dFromValues(1, 2, 3, 4, 5, 6))
+	actual := Vec2TransformMat2x3(Vec2Create(), vec2A, mat2x3A)
+	if !testSlice(actual, expect) {
+		t.Errorf("transform: %v", actual)
+	}
+}
+
+func TestMat2x3Str(t *testing.T) {
+	actual := Mat2x3Str(mat2x3A)
+	if actual != "mat2x3(1, 3, 5, 2, 4, 6)" {
+		t.Errorf("str: %v", actual)
+	}
+}
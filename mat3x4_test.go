@@ -0,0 +1,65 @@
+package glmatrix
+
+import "testing"
+
+var mat3x4A = []float64{
+	1, 4, 7, 11,
+	2, 5, 8, 12,
+	3, 6, 10, 13,
+}
+
+func TestMat3x4FromMat4x3(t *testing.T) {
+	actual := Mat3x4FromMat4x3(Mat3x4Create(), mat4x3A)
+	if !testSlice(actual, mat3x4A) {
+		t.Errorf("from mat4x3: %v", actual)
+	}
+}
+
+func TestMat3x4FromMat4(t *testing.T) {
+	m := testAffineMat4([]float64{1, 2, 3, 4}, []float64{5, 6, 7}, []float64{2, 3, 4})
+	actual := Mat3x4FromMat4(Mat3x4Create(), m)
+	expect := []float64{m[0], m[4], m[8], m[12], m[1], m[5], m[9], m[13], m[2], m[6], m[10], m[14]}
+	if !testSlice(actual, expect) {
+		t.Errorf("from mat4: %v", actual)
+	}
+	back := Mat4FromMat3x4(Mat4Create(), actual)
+	if !testSlice(back, m) {
+		t.Errorf("to mat4: %v", back)
+	}
+	if !testSlice(Mat4FromMat3x4(Mat4Create(), Mat3x4Create()), Mat4Identity(Mat4Create())) {
+		t.Errorf("identity")
+	}
+}
+
+func TestMat3x4Multiply(t *testing.T) {
+	a := testAffineMat4([]float64{1, 2, 3, 4}, []float64{5, 6, 7}, []float64{2, 3, 4})
+	b := testAffineMat4([]float64{-4, 3, 1, 2}, []float64{-1, 0, 2}, []float64{1, 0.5, 2})
+	expect := Mat3x4FromMat4(Mat3x4Create(), Mat4Multiply(Mat4Create(), a, b))
+	actual := Mat3x4Multiply(Mat3x4Create(), Mat3x4FromMat4(Mat3x4Create(), a), Mat3x4FromMat4(Mat3x4Create(), b))
+	if !testSlice(actual, expect) {
+		t.Errorf("multiply: %v", actual)
+	}
+}
+
+func TestMat3x4Invert(t *testing.T) {
+	expect := Mat3x4FromMat4x3(Mat3x4Create(), Mat4x3Invert(Mat4x3Create(), mat4x3A))
+	actual := Mat3x4Invert(Mat3x4Create(), mat3x4A)
+	if !testSlice(actual, expect) {
+		t.Errorf("invert: %v", actual)
+	}
+	if actual := Mat3x4Determinant(mat3x4A); !equals(actual, Mat4x3Determinant(mat4x3A)) {
+		t.Errorf("determinant: %v", actual)
+	}
+	identity := Mat3x4Multiply(Mat3x4Create(), mat3x4A, actual)
+	if !testSlice(identity, Mat3x4Create()) {
+		t.Errorf("invert multiply: %v", identity)
+	}
+}
+
+func TestVec3TransformMat3x4(t *testing.T) {
+	expect := Vec3TransformMat4x3(Vec3Create(), vec3A, mat4x3A)
+	actual := Vec3TransformMat3x4(Vec3Create(), vec3A, mat3x4A)
+	if !testSlice(actual, expect) {
+		t.Errorf("transform: %v", actual)
+	}
+}
@@ -0,0 +1,263 @@
+package glmatrix
+
+import "fmt"
+
+// A mat4x3 is a 3D affine transformation stored as the upper 3 rows of a mat4
+// in column-major order: the three columns of the linear part followed by the
+// translation, 12 values in total. The bottom row is implied to be [0, 0, 0, 1].
+
+// Mat4x3Create creates a new identity mat4x3
+func Mat4x3Create() []float64 {
+	return Mat4x3Identity(make([]float64, 12))
+}
+
+// Mat4x3Clone creates a new mat4x3 initialized with values from an existing matrix
+func Mat4x3Clone(a []float64) []float64 {
+	return Mat4x3Copy(make([]float64, 12), a)
+}
+
+// Mat4x3Copy copy the values from one mat4x3 to another
+func Mat4x3Copy(out, a []float64) []float64 {
+	copy(out[0:12], a[0:12])
+	return out
+}
+
+// Mat4x3Identity set a mat4x3 to the identity matrix
+func Mat4x3Identity(out []float64) []float64 {
+	out[0] = 1
+	out[1] = 0
+	out[2] = 0
+	out[3] = 0
+	out[4] = 1
+	out[5] = 0
+	out[6] = 0
+	out[7] = 0
+	out[8] = 1
+	out[9] = 0
+	out[10] = 0
+	out[11] = 0
+	return out
+}
+
+// Mat4x3FromMat4 copies the upper 3 rows of a mat4 into a mat4x3
+func Mat4x3FromMat4(out, a []float64) []float64 {
+	out[0] = a[0]
+	out[1] = a[1]
+	out[2] = a[2]
+	out[3] = a[4]
+	out[4] = a[5]
+	out[5] = a[6]
+	out[6] = a[8]
+	out[7] = a[9]
+	out[8] = a[10]
+	out[9] = a[12]
+	out[10] = a[13]
+	out[11] = a[14]
+	return out
+}
+
+// Mat4FromMat4x3 creates an affine mat4 from a mat4x3
+func Mat4FromMat4x3(out, a []float64) []float64 {
+	a00 := a[0]
+	a10 := a[1]
+	a20 := a[2]
+	a01 := a[3]
+	a11 := a[4]
+	a21 := a[5]
+	a02 := a[6]
+	a12 := a[7]
+	a22 := a[8]
+	a03 := a[9]
+	a13 := a[10]
+	a23 := a[11]
+	out[0] = a00
+	out[1] = a10
+	out[2] = a20
+	out[3] = 0
+	out[4] = a01
+	out[5] = a11
+	out[6] = a21
+	out[7] = 0
+	out[8] = a02
+	out[9] = a12
+	out[10] = a22
+	out[11] = 0
+	out[12] = a03
+	out[13] = a13
+	out[14] = a23
+	out[15] = 1
+	return out
+}
+
+// Mat4x3FromMat3x4 converts a mat3x4 into a mat4x3
+func Mat4x3FromMat3x4(out, a []float64) []float64 {
+	a00 := a[0]
+	a01 := a[1]
+	a02 := a[2]
+	a03 := a[3]
+	a10 := a[4]
+	a11 := a[5]
+	a12 := a[6]
+	a13 := a[7]
+	a20 := a[8]
+	a21 := a[9]
+	a22 := a[10]
+	a23 := a[11]
+	out[0] = a00
+	out[1] = a10
+	out[2] = a20
+	out[3] = a01
+	out[4] = a11
+	out[5] = a21
+	out[6] = a02
+	out[7] = a12
+	out[8] = a22
+	out[9] = a03
+	out[10] = a13
+	out[11] = a23
+	return out
+}
+
+// Mat4x3Multiply multiplies two mat4x3's as affine transformations
+func Mat4x3Multiply(out, a, b []float64) []float64 {
+	a00 := a[0]
+	a10 := a[1]
+	a20 := a[2]
+	a01 := a[3]
+	a11 := a[4]
+	a21 := a[5]
+	a02 := a[6]
+	a12 := a[7]
+	a22 := a[8]
+	a03 := a[9]
+	a13 := a[10]
+	a23 := a[11]
+	b00 := b[0]
+	b10 := b[1]
+	b20 := b[2]
+	b01 := b[3]
+	b11 := b[4]
+	b21 := b[5]
+	b02 := b[6]
+	b12 := b[7]
+	b22 := b[8]
+	b03 := b[9]
+	b13 := b[10]
+	b23 := b[11]
+	out[0] = a00*b00 + a01*b10 + a02*b20
+	out[1] = a10*b00 + a11*b10 + a12*b20
+	out[2] = a20*b00 + a21*b10 + a22*b20
+	out[3] = a00*b01 + a01*b11 + a02*b21
+	out[4] = a10*b01 + a11*b11 + a12*b21
+	out[5] = a20*b01 + a21*b11 + a22*b21
+	out[6] = a00*b02 + a01*b12 + a02*b22
+	out[7] = a10*b02 + a11*b12 + a12*b22
+	out[8] = a20*b02 + a21*b12 + a22*b22
+	out[9] = a00*b03 + a01*b13 + a02*b23 + a03
+	out[10] = a10*b03 + a11*b13 + a12*b23 + a13
+	out[11] = a20*b03 + a21*b13 + a22*b23 + a23
+	return out
+}
+
+// Mat4x3Determinant calculates the determinant of the linear part of a mat4x3
+func Mat4x3Determinant(a []float64) float64 {
+	a00 := a[0]
+	a10 := a[1]
+	a20 := a[2]
+	a01 := a[3]
+	a11 := a[4]
+	a21 := a[5]
+	a02 := a[6]
+	a12 := a[7]
+	a22 := a[8]
+	return a00*(a11*a22-a12*a21) + a01*(a12*a20-a10*a22) + a02*(a10*a21-a11*a20)
+}
+
+// Mat4x3Invert inverts a mat4x3 as an affine transformation.
+// It returns nil if the matrix is singular.
+func Mat4x3Invert(out, a []float64) []float64 {
+	a00 := a[0]
+	a10 := a[1]
+	a20 := a[2]
+	a01 := a[3]
+	a11 := a[4]
+	a21 := a[5]
+	a02 := a[6]
+	a12 := a[7]
+	a22 := a[8]
+	a03 := a[9]
+	a13 := a[10]
+	a23 := a[11]
+
+	det := a00*(a11*a22-a12*a21) + a01*(a12*a20-a10*a22) + a02*(a10*a21-a11*a20)
+	if det == 0. {
+		return nil
+	}
+	det = 1.0 / det
+
+	b00 := (a11*a22 - a12*a21) * det
+	b01 := (a02*a21 - a01*a22) * det
+	b02 := (a01*a12 - a02*a11) * det
+	b10 := (a12*a20 - a10*a22) * det
+	b11 := (a00*a22 - a02*a20) * det
+	b12 := (a02*a10 - a00*a12) * det
+	b20 := (a10*a21 - a11*a20) * det
+	b21 := (a01*a20 - a00*a21) * det
+	b22 := (a00*a11 - a01*a10) * det
+	b03 := -(b00*a03 + b01*a13 + b02*a23)
+	b13 := -(b10*a03 + b11*a13 + b12*a23)
+	b23 := -(b20*a03 + b21*a13 + b22*a23)
+	out[0] = b00
+	out[1] = b10
+	out[2] = b20
+	out[3] = b01
+	out[4] = b11
+	out[5] = b21
+	out[6] = b02
+	out[7] = b12
+	out[8] = b22
+	out[9] = b03
+	out[10] = b13
+	out[11] = b23
+	return out
+}
+
+// Vec3TransformMat4x3 transforms the Vec3 with a mat4x3
+func Vec3TransformMat4x3(out, a, m []float64) []float64 {
+	x := a[0]
+	y := a[1]
+	z := a[2]
+	out[0] = m[0]*x + m[3]*y + m[6]*z + m[9]
+	out[1] = m[1]*x + m[4]*y + m[7]*z + m[10]
+	out[2] = m[2]*x + m[5]*y + m[8]*z + m[11]
+	return out
+}
+
+// Mat4x3Str returns a string representation of a mat4x3
+func Mat4x3Str(a []float64) string {
+	return fmt.Sprintf("mat4x3(%v, %v, %v, %v, %v, %v, %v, %v, %v, %v, %v, %v)",
+		a[0], a[1], a[2], a[3], a[4], a[5], a[6], a[7], a[8], a[9], a[10], a[11])
+}
+
+// Mat4x3ExactEquals returns whether or not the matrices have exactly the same elements in the same position (when compared with ===)
+func Mat4x3ExactEquals(a, b []float64) bool {
+	for i := 0; i < 12; i++ {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Mat4x3Equals returns whether or not the matrices have approximately the same elements in the same position.
+func Mat4x3Equals(a, b []float64) bool {
+	for i := 0; i < 12; i++ {
+		if !equals(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Mat4x3Mul alias for Mat4x3Multiply
+var Mat4x3Mul = Mat4x3Multiply
@@ -0,0 +1,84 @@
+package glmatrix
+
+import "testing"
+
+func testAffineMat4(q, v, s []float64) []float64 {
+	QuatNormalize(q, q)
+	return Mat4FromRotationTranslationScale(Mat4Create(), q, v, s)
+}
+
+var mat4x3A = []float64{
+	1, 2, 3,
+	4, 5, 6,
+	7, 8, 10,
+	11, 12, 13,
+}
+
+func TestMat4x3Identity(t *testing.T) {
+	actual := Mat4FromMat4x3(Mat4Create(), Mat4x3Create())
+	if !testSlice(actual, Mat4Identity(Mat4Create())) {
+		t.Errorf("identity: %v", actual)
+	}
+}
+
+func TestMat4x3FromMat4(t *testing.T) {
+	m := testAffineMat4([]float64{1, 2, 3, 4}, []float64{5, 6, 7}, []float64{2, 3, 4})
+	actual := Mat4x3FromMat4(Mat4x3Create(), m)
+	expect := []float64{m[0], m[1], m[2], m[4], m[5], m[6], m[8], m[9], m[10], m[12], m[13], m[14]}
+	if !testSlice(actual, expect) {
+		t.Errorf("from mat4: %v", actual)
+	}
+	back := Mat4FromMat4x3(Mat4Create(), actual)
+	if !testSlice(back, m) {
+		t.Errorf("to mat4: %v", back)
+	}
+}
+
+func TestMat4x3Multiply(t *testing.T) {
+	a := testAffineMat4([]float64{1, 2, 3, 4}, []float64{5, 6, 7}, []float64{2, 3, 4})
+	b := testAffineMat4([]float64{-4, 3, 1, 2}, []float64{-1, 0, 2}, []float64{1, 0.5, 2})
+	expect := Mat4x3FromMat4(Mat4x3Create(), Mat4Multiply(Mat4Create(), a, b))
+	actual := Mat4x3Multiply(Mat4x3Create(), Mat4x3FromMat4(Mat4x3Create(), a), Mat4x3FromMat4(Mat4x3Create(), b))
+	if !testSlice(actual, expect) {
+		t.Errorf("multiply: %v", actual)
+	}
+}
+
+func TestMat4x3Invert(t *testing.T) {
+	m := Mat4FromMat4x3(Mat4Create(), mat4x3A)
+	expect := Mat4x3FromMat4(Mat4x3Create(), Mat4Invert(Mat4Create(), m))
+	actual := Mat4x3Invert(Mat4x3Create(), mat4x3A)
+	if !testSlice(actual, expect) {
+		t.Errorf("invert: %v", actual)
+	}
+	if actual := Mat4x3Determinant(mat4x3A); !equals(actual, Mat4Determinant(m)) {
+		t.Errorf("determinant: %v", actual)
+	}
+	singular := []float64{1, 2, 3, 2, 4, 6, 0, 0, 1, 1, 1, 1}
+	if actual := Mat4x3Invert(Mat4x3Create(), singular); actual != nil {
+		t.Errorf("invert singular: %v", actual)
+	}
+}
+
+func TestVec3TransformMat4x3(t *testing.T) {
+	m := Mat4FromMat4x3(Mat4Create(), mat4x3A)
+	expect := Vec3TransformMat4(Vec3Create(), vec3A, m)
+	actual := Vec3TransformMat4x3(Vec3Create(), vec3A, mat4x3A)
+	if !testSlice(actual, expect) {
+		t.Errorf("transform: %v", actual)
+	}
+}
+
+func TestMat4x3FromMat3x4(t *testing.T) {
+	actual := Mat4x3FromMat3x4(Mat4x3Create(), Mat3x4FromMat4x3(Mat3x4Create(), mat4x3A))
+	if !Mat4x3ExactEquals(actual, mat4x3A) {
+		t.Errorf("round trip: %v", actual)
+	}
+}
+
+func TestMat4x3Str(t *testing.T) {
+	actual := Mat4x3Str(mat4x3A)
+	if actual != "mat4x3(1, 2, 3, 4, 5, 6, 7, 8, 10, 11, 12, 13)" {
+		t.Errorf("str: %v", actual)
+	}
+}
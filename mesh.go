@@ -0,0 +1,179 @@
+package glmatrix
+
+import "fmt"
+
+// Mesh is an indexed triangle mesh. The vertex attributes are flat buffers in
+// the layout of Vec3ForEach and Vec2ForEach, so they can be processed directly
+// by the vector functions of this package.
+type Mesh struct {
+	// Positions holds 3 values per vertex
+	Positions []float64
+
+	// Normals holds 3 values per vertex, or is nil
+	Normals []float64
+
+	// UVs holds 2 values per vertex, or is nil
+	UVs []float64
+
+	// Tangents holds 4 values per vertex, or is nil. The fourth value is the
+	// handedness of the tangent space, 1 or -1, and the bitangent is the cross
+	// product of the normal and the tangent multiplied by it.
+	Tangents []float64
+
+	// Indices holds 3 vertex indices per triangle in counter-clockwise order
+	Indices []int
+}
+
+// MeshEncoding is an encoding of the mesh file formats which have both a text
+// and a binary variant
+type MeshEncoding string
+
+const (
+	// MeshASCII is the text encoding
+	MeshASCII MeshEncoding = "ascii"
+
+	// MeshBinary is the binary encoding, which is little-endian for PLY
+	MeshBinary MeshEncoding = "binary"
+)
+
+// VertexCount returns the number of vertices of a mesh
+func (m *Mesh) VertexCount() int {
+	return len(m.Positions) / 3
+}
+
+// TriangleCount returns the number of triangles of a mesh
+func (m *Mesh) TriangleCount() int {
+	return len(m.Indices) / 3
+}
+
+// Transform transforms the positions of a mesh with a mat4, the normals
+// with the normal matrix of Mat3NormalFromMat4 and the tangents with the
+// upper-left mat3, renormalizing the directions. The winding of the triangles
+// and the handedness of the tangents are reversed if the matrix mirrors the
+// mesh so that they keep facing outward. The normals are left unchanged if the
+// matrix is singular. It returns the mesh, which is transformed in place.
+func (m *Mesh) Transform(mat []float64) *Mesh {
+	Vec3ForEach(m.Positions, 0, 0, 0, func(out, a, arg []float64) {
+		Vec3TransformMat4(out, a, arg)
+	}, mat)
+	if m.Normals != nil {
+		if normal := Mat3NormalFromMat4(Mat3Create(), mat); normal != nil {
+			Vec3ForEach(m.Normals, 0, 0, 0, func(out, a, arg []float64) {
+				Vec3Normalize(out, Vec3TransformMat3(out, a, arg))
+			}, normal)
+		}
+	}
+	mirror := Mat4Determinant(mat) < 0
+	if m.Tangents != nil {
+		linear := Mat3FromMat4(Mat3Create(), mat)
+		Vec4ForEach(m.Tangents, 0, 0, 0, func(out, a, arg []float64) {
+			Vec3Normalize(out, Vec3TransformMat3(out, a, arg))
+			if mirror {
+				out[3] = -a[3]
+			}
+		}, linear)
+	}
+	if mirror {
+		for i := 0; i+2 < len(m.Indices); i += 3 {
+			m.Indices[i+1], m.Indices[i+2] = m.Indices[i+2], m.Indices[i+1]
+		}
+	}
+	return m
+}
+
+// validate checks the lengths of the buffers and the range of the indices
+func (m *Mesh) validate() error {
+	n := m.VertexCount()
+	if len(m.Positions) != n*3 {
+		return fmt.Errorf("glmatrix: mesh positions must have 3 values per vertex but have %v", len(m.Positions))
+	}
+	if m.Normals != nil && len(m.Normals) != n*3 {
+		return fmt.Errorf("glmatrix: mesh must have %v normal values for %v vertices but has %v", n*3, n, len(m.Normals))
+	}
+	if m.UVs != nil && len(m.UVs) != n*2 {
+		return fmt.Errorf("glmatrix: mesh must have %v uv values for %v vertices but has %v", n*2, n, len(m.UVs))
+	}
+	if m.Tangents != nil && len(m.Tangents) != n*4 {
+		return fmt.Errorf("glmatrix: mesh must have %v tangent values for %v vertices but has %v", n*4, n, len(m.Tangents))
+	}
+	if len(m.Indices)%3 != 0 {
+		return fmt.Errorf("glmatrix: mesh indices must have 3 values per triangle but have %v", len(m.Indices))
+	}
+	for i, index := range m.Indices {
+		if index < 0 || index >= n {
+			return fmt.Errorf("glmatrix: mesh index %v at %v is out of range for %v vertices", index, i, n)
+		}
+	}
+	return nil
+}
+
+// triangleNormal calculates the unit normal of the ith triangle of a mesh,
+// or a zero vector for a degenerate triangle
+func (m *Mesh) triangleNormal(out []float64, i int) []float64 {
+	a := m.Positions[m.Indices[i*3]*3:]
+	b := m.Positions[m.Indices[i*3+1]*3:]
+	c := m.Positions[m.Indices[i*3+2]*3:]
+	ab := Vec3Subtract(Vec3Create(), b, a)
+	ac := Vec3Subtract(Vec3Create(), c, a)
+	return Vec3Normalize(out, Vec3Cross(out, ab, ac))
+}
+
+// meshAttributes collects the vertices of a mesh made of polygons whose
+// corners may have their own attributes, as in OBJ and STL files
+type meshAttributes struct {
+	mesh       *Mesh
+	hasNormals bool
+	hasUVs     bool
+}
+
+// addVertex appends a vertex. A missing normal or uv is zero.
+func (b *meshAttributes) addVertex(position, normal, uv []float64) int {
+	m := b.mesh
+	index := m.VertexCount()
+	m.Positions = append(m.Positions, position[0], position[1], position[2])
+	if normal != nil {
+		b.hasNormals = true
+		m.Normals = append(m.Normals, normal[0], normal[1], normal[2])
+	} else {
+		m.Normals = append(m.Normals, 0, 0, 0)
+	}
+	if uv != nil {
+		b.hasUVs = true
+		m.UVs = append(m.UVs, uv[0], uv[1])
+	} else {
+		m.UVs = append(m.UVs, 0, 0)
+	}
+	return index
+}
+
+// addPolygon appends a convex polygon as a fan of triangles
+func (b *meshAttributes) addPolygon(indices []int) {
+	for i := 2; i < len(indices); i++ {
+		b.mesh.Indices = append(b.mesh.Indices, indices[0], indices[i-1], indices[i])
+	}
+}
+
+// finish drops the attributes no vertex has
+func (b *meshAttributes) finish() *Mesh {
+	m := b.mesh
+	if !b.hasNormals {
+		m.Normals = nil
+	}
+	if !b.hasUVs {
+		m.UVs = nil
+	}
+	if m.Positions == nil {
+		m.Positions = []float64{}
+	}
+	if m.Indices == nil {
+		m.Indices = []int{}
+	}
+	return m
+}
+
+// checkEncoding panics with an unknown encoding
+func checkEncoding(encoding MeshEncoding) {
+	if encoding != MeshASCII && encoding != MeshBinary {
+		panic(fmt.Sprintf("Unknown mesh encoding %v", encoding))
+	}
+}
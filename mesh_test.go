@@ -0,0 +1,78 @@
+package glmatrix
+
+import (
+	"math"
+	"testing"
+)
+
+// testQuadMesh returns a unit square on the xy plane facing +z
+func testQuadMesh() *Mesh {
+	return &Mesh{
+		Positions: []float64{0, 0, 0, 1, 0, 0, 1, 1, 0, 0, 1, 0},
+		Normals:   []float64{0, 0, 1, 0, 0, 1, 0, 0, 1, 0, 0, 1},
+		UVs:       []float64{0, 0, 1, 0, 1, 1, 0, 1},
+		Indices:   []int{0, 1, 2, 0, 2, 3},
+	}
+}
+
+func TestMeshTransform(t *testing.T) {
+	m := testQuadMesh()
+	mat := Mat4FromTranslation(Mat4Create(), []float64{1, 2, 3})
+	Mat4RotateX(mat, mat, math.Pi/2)
+	Mat4Scale(mat, mat, []float64{2, 2, 2})
+	m.Transform(mat)
+	if !testSlice(m.Positions, []float64{1, 2, 3, 3, 2, 3, 3, 2, 5, 1, 2, 5}) {
+		t.Errorf("transform positions: %v", m.Positions)
+	}
+	if !testSlice(m.Normals, []float64{0, -1, 0, 0, -1, 0, 0, -1, 0, 0, -1, 0}) {
+		t.Errorf("transform normals: %v", m.Normals)
+	}
+	if !testSlice(m.UVs, testQuadMesh().UVs) {
+		t.Errorf("transform uvs: %v", m.UVs)
+	}
+	n := m.triangleNormal(Vec3Create(), 1)
+	if !testSlice(n, []float64{0, -1, 0}) {
+		t.Errorf("transform winding: %v", n)
+	}
+}
+
+func TestMeshTransformMirror(t *testing.T) {
+	m := testQuadMesh()
+	m.Tangents = []float64{2, 0, 0, 1, 2, 0, 0, 1, 2, 0, 0, 1, 2, 0, 0, 1}
+	m.Transform(Mat4FromScaling(Mat4Create(), []float64{1, 1, -3}))
+	if !testSlice(m.Tangents[0:4], []float64{1, 0, 0, -1}) {
+		t.Errorf("mirror tangents: %v", m.Tangents)
+	}
+	if !testSlice(m.Normals[0:3], []float64{0, 0, -1}) {
+		t.Errorf("mirror normals: %v", m.Normals)
+	}
+	if m.Indices[1] != 2 || m.Indices[2] != 1 {
+		t.Errorf("mirror indices: %v", m.Indices)
+	}
+	n := m.triangleNormal(Vec3Create(), 0)
+	if !testSlice(n, []float64{0, 0, -1}) {
+		t.Errorf("mirror winding: %v", n)
+	}
+}
+
+func TestMeshValidate(t *testing.T) {
+	cases := map[string]func(m *Mesh){
+		"positions": func(m *Mesh) { m.Positions = m.Positions[:11] },
+		"normals":   func(m *Mesh) { m.Normals = m.Normals[:9] },
+		"uvs":       func(m *Mesh) { m.UVs = m.UVs[:6] },
+		"tangents":  func(m *Mesh) { m.Tangents = make([]float64, 15) },
+		"indices":   func(m *Mesh) { m.Indices = m.Indices[:5] },
+		"range":     func(m *Mesh) { m.Indices[4] = 4 },
+		"negative":  func(m *Mesh) { m.Indices[0] = -1 },
+	}
+	if err := testQuadMesh().validate(); err != nil {
+		t.Errorf("validate: %v", err)
+	}
+	for name, modify := range cases {
+		m := testQuadMesh()
+		modify(m)
+		if err := m.validate(); err == nil {
+			t.Errorf("validate %v: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,264 @@
+package glmatrix
+
+import (
+	"fmt"
+	"math"
+)
+
+// NormalWeighting is how the normals of the triangles around a vertex are
+// weighted when they are averaged into a smooth vertex normal
+type NormalWeighting string
+
+const (
+	// AreaWeighted weights the normal of a triangle by its area
+	AreaWeighted NormalWeighting = "area"
+
+	// AngleWeighted weights the normal of a triangle by its angle at the vertex,
+	// which does not depend on how the surface around the vertex is triangulated
+	AngleWeighted NormalWeighting = "angle"
+)
+
+// FaceNormals returns the unit normals of the triangles of a mesh, 3 values
+// per triangle, or nil if the buffers of the mesh are inconsistent. The normal
+// of a degenerate triangle is zero.
+func (m *Mesh) FaceNormals() []float64 {
+	if m.validate() != nil {
+		return nil
+	}
+	normals := make([]float64, m.TriangleCount()*3)
+	for i := 0; i < m.TriangleCount(); i++ {
+		m.triangleNormal(normals[i*3:i*3+3], i)
+	}
+	return normals
+}
+
+// ComputeNormals replaces the normals of a mesh with smooth vertex normals
+// averaged from the triangles sharing each vertex. Only vertices with the same
+// index are smoothed together, so seams of split vertices stay sharp. The
+// normal of a vertex without triangles is zero. It returns an error if the
+// buffers of the mesh are inconsistent.
+func (m *Mesh) ComputeNormals(weighting NormalWeighting) error {
+	if weighting != AreaWeighted && weighting != AngleWeighted {
+		panic(fmt.Sprintf("Unknown normal weighting %v", weighting))
+	}
+	if err := m.validate(); err != nil {
+		return err
+	}
+	normals := make([]float64, m.VertexCount()*3)
+	normal := Vec3Create()
+	ab := Vec3Create()
+	ac := Vec3Create()
+	for i := 0; i+2 < len(m.Indices); i += 3 {
+		a := m.Positions[m.Indices[i]*3:]
+		b := m.Positions[m.Indices[i+1]*3:]
+		c := m.Positions[m.Indices[i+2]*3:]
+		Vec3Subtract(ab, b, a)
+		Vec3Subtract(ac, c, a)
+		// the length of the cross product is twice the area of the triangle
+		Vec3Cross(normal, ab, ac)
+		if weighting == AngleWeighted {
+			Vec3Normalize(normal, normal)
+		}
+		for j := 0; j < 3; j++ {
+			weight := 1.0
+			if weighting == AngleWeighted {
+				weight = m.cornerAngle(i, j)
+			}
+			k := m.Indices[i+j] * 3
+			Vec3ScaleAndAdd(normals[k:k+3], normals[k:k+3], normal, weight)
+		}
+	}
+	Vec3ForEach(normals, 0, 0, 0, func(out, a, arg []float64) {
+		Vec3Normalize(out, a)
+	}, nil)
+	m.Normals = normals
+	return nil
+}
+
+// ComputeFlatNormals gives every triangle of a mesh its own three vertices
+// whose normals are the normal of the triangle. The uvs are kept and the
+// tangents are removed. It returns an error if the buffers of the mesh are
+// inconsistent.
+func (m *Mesh) ComputeFlatNormals() error {
+	if err := m.validate(); err != nil {
+		return err
+	}
+	positions := make([]float64, len(m.Indices)*3)
+	normals := make([]float64, len(m.Indices)*3)
+	var uvs []float64
+	if m.UVs != nil {
+		uvs = make([]float64, len(m.Indices)*2)
+	}
+	indices := make([]int, len(m.Indices))
+	for i, index := range m.Indices {
+		copy(positions[i*3:i*3+3], m.Positions[index*3:index*3+3])
+		if uvs != nil {
+			copy(uvs[i*2:i*2+2], m.UVs[index*2:index*2+2])
+		}
+		indices[i] = i
+	}
+	m.Positions, m.UVs, m.Indices, m.Tangents = positions, uvs, indices, nil
+	m.Normals = normals
+	for i := 0; i < m.TriangleCount(); i++ {
+		normal := m.triangleNormal(normals[i*9:i*9+3], i)
+		copy(normals[i*9+3:], normal)
+		copy(normals[i*9+6:], normal)
+	}
+	return nil
+}
+
+// ComputeTangents calculates the tangents of a mesh from its positions,
+// normals and uvs. It is an approximation of MikkTSpace which stores the
+// tangents the same way but only averages over the triangles sharing a vertex
+// index and only splits vertices on mirrored uvs, so the tangents may differ
+// from those of MikkTSpace tools along seams. The tangent of a vertex points
+// along increasing u and is the angle-weighted average of the tangents of its
+// triangles orthogonalized against the normal, and its handedness is the sign
+// of the direction of increasing v relative to the cross product of the normal
+// and the tangent. A vertex shared by triangles with mirrored uvs is split in
+// two so that each copy has a single handedness. Triangles with degenerate uvs
+// do not contribute, and a vertex without any contribution gets an arbitrary
+// tangent orthogonal to its normal.
+func (m *Mesh) ComputeTangents() error {
+	if err := m.validate(); err != nil {
+		return err
+	}
+	if m.Normals == nil || m.UVs == nil {
+		return fmt.Errorf("glmatrix: tangents need the normals and uvs of the mesh")
+	}
+	n := m.VertexCount()
+	// the sums of the tangents with a positive and a negative handedness
+	sums := [2][]float64{make([]float64, n*3), make([]float64, n*3)}
+	used := [2][]bool{make([]bool, n), make([]bool, n)}
+	// the handedness of each corner, 0 for a corner without a tangent
+	signs := make([]int, len(m.Indices))
+
+	tangent := Vec3Create()
+	bitangent := Vec3Create()
+	projected := Vec3Create()
+	cross := Vec3Create()
+	for i := 0; i+2 < len(m.Indices); i += 3 {
+		if !m.triangleTangent(tangent, bitangent, i) {
+			continue
+		}
+		for j := 0; j < 3; j++ {
+			index := m.Indices[i+j]
+			normal := m.Normals[index*3 : index*3+3]
+			Vec3ScaleAndAdd(projected, tangent, normal, -Vec3Dot(normal, tangent))
+			if Vec3SquaredLength(projected) == 0 {
+				continue
+			}
+			Vec3Normalize(projected, projected)
+			side := 0
+			signs[i+j] = 1
+			if Vec3Dot(Vec3Cross(cross, normal, tangent), bitangent) < 0 {
+				side = 1
+				signs[i+j] = -1
+			}
+			sum := sums[side][index*3 : index*3+3]
+			Vec3ScaleAndAdd(sum, sum, projected, m.cornerAngle(i, j))
+			used[side][index] = true
+		}
+	}
+
+	// split the vertices which have tangents of both handednesses
+	split := make([]int, n)
+	for i := 0; i < n; i++ {
+		split[i] = i
+		if used[0][i] && used[1][i] {
+			split[i] = m.VertexCount()
+			m.Positions = append(m.Positions, m.Positions[i*3:i*3+3]...)
+			m.Normals = append(m.Normals, m.Normals[i*3:i*3+3]...)
+			m.UVs = append(m.UVs, m.UVs[i*2:i*2+2]...)
+		}
+	}
+	for i, sign := range signs {
+		if sign < 0 {
+			m.Indices[i] = split[m.Indices[i]]
+		}
+	}
+
+	m.Tangents = make([]float64, m.VertexCount()*4)
+	for i := 0; i < n; i++ {
+		if used[0][i] || !used[1][i] {
+			m.setTangent(i, sums[0][i*3:i*3+3], 1)
+		}
+		if used[1][i] {
+			m.setTangent(split[i], sums[1][i*3:i*3+3], -1)
+		}
+	}
+	return nil
+}
+
+// Bitangents returns the bitangents of a mesh, 3 values per vertex, which are
+// the cross products of the normals and the tangents multiplied by their
+// handedness. It returns nil if the mesh has no normals or tangents.
+func (m *Mesh) Bitangents() []float64 {
+	if m.Normals == nil || m.Tangents == nil {
+		return nil
+	}
+	bitangents := make([]float64, m.VertexCount()*3)
+	for i := 0; i < m.VertexCount(); i++ {
+		b := bitangents[i*3 : i*3+3]
+		Vec3Cross(b, m.Normals[i*3:i*3+3], m.Tangents[i*4:i*4+3])
+		Vec3Scale(b, b, m.Tangents[i*4+3])
+	}
+	return bitangents
+}
+
+// cornerAngle returns the angle of the triangle starting at the ith index at
+// its jth corner
+func (m *Mesh) cornerAngle(i, j int) float64 {
+	a := m.Positions[m.Indices[i+j]*3:]
+	b := m.Positions[m.Indices[i+(j+1)%3]*3:]
+	c := m.Positions[m.Indices[i+(j+2)%3]*3:]
+	ab := Vec3Subtract(Vec3Create(), b, a)
+	ac := Vec3Subtract(Vec3Create(), c, a)
+	if Vec3SquaredLength(ab) == 0 || Vec3SquaredLength(ac) == 0 {
+		return 0
+	}
+	return Vec3Angle(ab, ac)
+}
+
+// triangleTangent calculates the directions of increasing u and v of the
+// triangle starting at the ith index. It returns false if the uvs of the
+// triangle are degenerate.
+func (m *Mesh) triangleTangent(tangent, bitangent []float64, i int) bool {
+	a, b, c := m.Indices[i], m.Indices[i+1], m.Indices[i+2]
+	ab := Vec3Subtract(Vec3Create(), m.Positions[b*3:], m.Positions[a*3:])
+	ac := Vec3Subtract(Vec3Create(), m.Positions[c*3:], m.Positions[a*3:])
+	du1 := m.UVs[b*2] - m.UVs[a*2]
+	dv1 := m.UVs[b*2+1] - m.UVs[a*2+1]
+	du2 := m.UVs[c*2] - m.UVs[a*2]
+	dv2 := m.UVs[c*2+1] - m.UVs[a*2+1]
+	r := du1*dv2 - du2*dv1
+	if r == 0 {
+		return false
+	}
+	Vec3Scale(tangent, ab, dv2/r)
+	Vec3ScaleAndAdd(tangent, tangent, ac, -dv1/r)
+	Vec3Scale(bitangent, ac, du1/r)
+	Vec3ScaleAndAdd(bitangent, bitangent, ab, -du2/r)
+	return true
+}
+
+// setTangent orthogonalizes a sum of tangents against the normal of the ith
+// vertex and stores it with the handedness
+func (m *Mesh) setTangent(i int, sum []float64, sign float64) {
+	normal := m.Normals[i*3 : i*3+3]
+	tangent := m.Tangents[i*4 : i*4+4]
+	Vec3ScaleAndAdd(tangent, sum, normal, -Vec3Dot(normal, sum))
+	if Vec3SquaredLength(tangent) == 0 {
+		// any direction orthogonal to the normal
+		axis := []float64{1, 0, 0}
+		if math.Abs(normal[0]) > 0.9 {
+			axis = []float64{0, 1, 0}
+		}
+		Vec3ScaleAndAdd(tangent, axis, normal, -Vec3Dot(normal, axis))
+		if Vec3SquaredLength(tangent) == 0 {
+			copy(tangent, axis)
+		}
+	}
+	Vec3Normalize(tangent, tangent)
+	tangent[3] = sign
+}
@@ -0,0 +1,190 @@
+package glmatrix
+
+import (
+	"math"
+	"testing"
+)
+
+// testCornerMesh returns two triangles meeting at right angles at the origin
+// whose areas are 2 and 0.5
+func testCornerMesh() *Mesh {
+	return &Mesh{
+		Positions: []float64{0, 0, 0, 2, 0, 0, 0, 2, 0, 0, 0, 1, 1, 0, 0},
+		Indices:   []int{0, 1, 2, 0, 3, 4},
+	}
+}
+
+func TestMeshFaceNormals(t *testing.T) {
+	m := testQuadMesh()
+	m.Positions[6] = 0 // the second triangle becomes degenerate
+	actual := m.FaceNormals()
+	expect := []float64{0, 0, 1, 0, 0, 0}
+	if !testSlice(actual, expect) {
+		t.Errorf("face normals: %v", actual)
+	}
+}
+
+func TestMeshComputeNormalsArea(t *testing.T) {
+	m := testCornerMesh()
+	if err := m.ComputeNormals(AreaWeighted); err != nil {
+		t.Fatalf("area weighted normals: %v", err)
+	}
+	actual := m.Normals[0:3]
+	expect := Vec3Normalize(Vec3Create(), []float64{0, 1, 4})
+	if !testSlice(actual, expect) {
+		t.Errorf("area weighted normals: %v", actual)
+	}
+	actual = m.Normals[3:6]
+	expect = []float64{0, 0, 1}
+	if !testSlice(actual, expect) {
+		t.Errorf("area weighted normals: %v", actual)
+	}
+}
+
+func TestMeshComputeNormalsAngle(t *testing.T) {
+	m := testCornerMesh()
+	if err := m.ComputeNormals(AngleWeighted); err != nil {
+		t.Fatalf("angle weighted normals: %v", err)
+	}
+	actual := m.Normals[0:3]
+	expect := []float64{0, math.Sqrt2 / 2, math.Sqrt2 / 2}
+	if !testSlice(actual, expect) {
+		t.Errorf("angle weighted normals: %v", actual)
+	}
+	actual = m.Normals[9:12]
+	expect = []float64{0, 1, 0}
+	if !testSlice(actual, expect) {
+		t.Errorf("angle weighted normals: %v", actual)
+	}
+}
+
+func TestMeshComputeNormalsSphere(t *testing.T) {
+	m := NewIcosphereMesh(1, 2, nil)
+	expect := append([]float64{}, m.Positions...)
+	m.Normals = nil
+	if err := m.ComputeNormals(AngleWeighted); err != nil {
+		t.Fatalf("sphere normals: %v", err)
+	}
+	if !testSliceTol(m.Normals, expect, 1e-2) {
+		t.Errorf("sphere normals: %v", m.Normals[0:3])
+	}
+}
+
+func TestMeshComputeNormalsUnknown(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("unknown weighting should panic")
+		}
+	}()
+	testQuadMesh().ComputeNormals("uniform")
+}
+
+func TestMeshComputeNormalsInvalid(t *testing.T) {
+	m := testQuadMesh()
+	m.Indices[4] = 4
+	if err := m.ComputeNormals(AreaWeighted); err == nil {
+		t.Errorf("normals of an invalid mesh should fail")
+	}
+	if err := m.ComputeFlatNormals(); err == nil {
+		t.Errorf("flat normals of an invalid mesh should fail")
+	}
+	if normals := m.FaceNormals(); normals != nil {
+		t.Errorf("face normals of an invalid mesh: %v", normals)
+	}
+}
+
+func TestMeshComputeFlatNormals(t *testing.T) {
+	m := testCornerMesh()
+	if err := m.ComputeFlatNormals(); err != nil {
+		t.Fatalf("flat normals: %v", err)
+	}
+	if err := m.validate(); err != nil {
+		t.Fatalf("flat normals: %v", err)
+	}
+	actual := m.Normals
+	expect := []float64{0, 0, 1, 0, 0, 1, 0, 0, 1, 0, 1, 0, 0, 1, 0, 0, 1, 0}
+	if !testSlice(actual, expect) {
+		t.Errorf("flat normals: %v", actual)
+	}
+	actual = m.Positions[9:12]
+	expect = []float64{0, 0, 0}
+	if !testSlice(actual, expect) || m.UVs != nil {
+		t.Errorf("flat positions: %v", actual)
+	}
+	if !testIntSlice(m.Indices, []int{0, 1, 2, 3, 4, 5}) {
+		t.Errorf("flat indices: %v", m.Indices)
+	}
+}
+
+func TestMeshComputeTangents(t *testing.T) {
+	m := testQuadMesh()
+	Vec2ForEach(m.UVs, 0, 0, 0, func(out, a, arg []float64) {
+		Vec2Scale(out, a, 3)
+	}, nil)
+	if err := m.ComputeTangents(); err != nil {
+		t.Fatalf("tangents: %v", err)
+	}
+	actual := m.Tangents
+	expect := []float64{1, 0, 0, 1, 1, 0, 0, 1, 1, 0, 0, 1, 1, 0, 0, 1}
+	if !testSlice(actual, expect) {
+		t.Errorf("tangents: %v", actual)
+	}
+	actual = m.Bitangents()
+	expect = []float64{0, 1, 0, 0, 1, 0, 0, 1, 0, 0, 1, 0}
+	if !testSlice(actual, expect) {
+		t.Errorf("bitangents: %v", actual)
+	}
+}
+
+func TestMeshComputeTangentsMirrored(t *testing.T) {
+	// two quads whose u increases away from their shared edge at x = 0
+	m := &Mesh{
+		Positions: []float64{-1, 0, 0, 0, 0, 0, 0, 1, 0, -1, 1, 0, 1, 0, 0, 1, 1, 0},
+		Normals:   []float64{0, 0, 1, 0, 0, 1, 0, 0, 1, 0, 0, 1, 0, 0, 1, 0, 0, 1},
+		UVs:       []float64{1, 0, 0, 0, 0, 1, 1, 1, 1, 0, 1, 1},
+		Indices:   []int{0, 1, 2, 0, 2, 3, 1, 4, 5, 1, 5, 2},
+	}
+	if err := m.ComputeTangents(); err != nil {
+		t.Fatalf("mirrored tangents: %v", err)
+	}
+	if err := m.validate(); err != nil || m.VertexCount() != 8 {
+		t.Fatalf("mirrored vertices: %v %v", m.VertexCount(), err)
+	}
+	for i, index := range m.Indices {
+		actual := m.Tangents[index*4 : index*4+4]
+		expect := []float64{-1, 0, 0, -1}
+		if i >= 6 {
+			expect = []float64{1, 0, 0, 1}
+		}
+		if !testSlice(actual, expect) {
+			t.Errorf("mirrored tangent of corner %v: %v", i, actual)
+		}
+	}
+	actual := m.Bitangents()[m.Indices[0]*3:][:3]
+	expect := []float64{0, 1, 0}
+	if !testSlice(actual, expect) {
+		t.Errorf("mirrored bitangent: %v", actual)
+	}
+}
+
+func TestMeshComputeTangentsSphere(t *testing.T) {
+	m := NewUVSphereMesh(2, 16, 8, nil)
+	if err := m.ComputeTangents(); err != nil {
+		t.Fatalf("sphere tangents: %v", err)
+	}
+	for i := 0; i < m.VertexCount(); i++ {
+		tangent := m.Tangents[i*4 : i*4+4]
+		normal := m.Normals[i*3 : i*3+3]
+		if math.Abs(Vec3Length(tangent)-1) > 1e-9 || math.Abs(Vec3Dot(tangent, normal)) > 1e-9 || math.Abs(tangent[3]) != 1 {
+			t.Fatalf("sphere tangent %v: %v", i, tangent)
+		}
+	}
+}
+
+func TestMeshComputeTangentsMissing(t *testing.T) {
+	m := testQuadMesh()
+	m.UVs = nil
+	if err := m.ComputeTangents(); err == nil {
+		t.Errorf("tangents without uvs should fail")
+	}
+}
@@ -0,0 +1,158 @@
+package glmatrix
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ReadOBJ reads the polygons of a Wavefront OBJ file into a mesh. Polygons are
+// split into triangle fans and each distinct combination of position, uv and
+// normal indices becomes a vertex. Negative indices are relative to the end of
+// the attributes read so far. Statements other than v, vt, vn and f, such as
+// groups and materials, are ignored. The normals and uvs of the mesh are nil if
+// no face references them.
+func ReadOBJ(r io.Reader) (*Mesh, error) {
+	var positions, uvs, normals [][]float64
+	vertices := map[[3]int]int{}
+	b := &meshAttributes{mesh: &Mesh{}}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 1<<20)
+	line := 0
+	errorf := func(format string, args ...interface{}) error {
+		return fmt.Errorf("glmatrix: invalid OBJ at line %v: %v", line, fmt.Sprintf(format, args...))
+	}
+	for scanner.Scan() {
+		line++
+		text := scanner.Text()
+		if i := strings.IndexByte(text, '#'); i >= 0 {
+			text = text[:i]
+		}
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+		switch fields[0] {
+		case "v", "vt", "vn":
+			min, max := 3, 3
+			if fields[0] == "vt" {
+				min = 1
+			}
+			if len(fields)-1 < min {
+				return nil, errorf("%v needs %v values but has %v", fields[0], min, len(fields)-1)
+			}
+			if len(fields)-1 < max {
+				max = len(fields) - 1
+			}
+			v := make([]float64, 3)
+			for i := 0; i < max; i++ {
+				f, err := strconv.ParseFloat(fields[i+1], 64)
+				if err != nil {
+					return nil, errorf("invalid number %q", fields[i+1])
+				}
+				v[i] = f
+			}
+			switch fields[0] {
+			case "v":
+				positions = append(positions, v)
+			case "vt":
+				uvs = append(uvs, v[0:2])
+			case "vn":
+				normals = append(normals, v)
+			}
+		case "f":
+			if len(fields) < 4 {
+				return nil, errorf("face needs 3 vertices but has %v", len(fields)-1)
+			}
+			polygon := make([]int, len(fields)-1)
+			for i, field := range fields[1:] {
+				refs := strings.Split(field, "/")
+				if len(refs) > 3 {
+					return nil, errorf("invalid vertex %q", field)
+				}
+				key := [3]int{-1, -1, -1}
+				counts := []int{len(positions), len(uvs), len(normals)}
+				for j, ref := range refs {
+					if ref == "" && j > 0 {
+						continue
+					}
+					index, err := strconv.Atoi(ref)
+					if err != nil {
+						return nil, errorf("invalid vertex %q", field)
+					}
+					if index < 0 {
+						index += counts[j]
+					} else {
+						index--
+					}
+					if index < 0 || index >= counts[j] {
+						return nil, errorf("vertex %q refers to %v attributes of which %v are defined", field, []string{"position", "uv", "normal"}[j], counts[j])
+					}
+					key[j] = index
+				}
+				index, ok := vertices[key]
+				if !ok {
+					var uv, normal []float64
+					if key[1] >= 0 {
+						uv = uvs[key[1]]
+					}
+					if key[2] >= 0 {
+						normal = normals[key[2]]
+					}
+					index = b.addVertex(positions[key[0]], normal, uv)
+					vertices[key] = index
+				}
+				polygon[i] = index
+			}
+			b.addPolygon(polygon)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return b.finish(), nil
+}
+
+// WriteOBJ writes a mesh as a Wavefront OBJ file with one v, vt and vn
+// statement per vertex and one triangle per f statement
+func WriteOBJ(w io.Writer, m *Mesh) error {
+	if err := m.validate(); err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(w)
+	for i := 0; i < m.VertexCount(); i++ {
+		p := m.Positions[i*3:]
+		fmt.Fprintf(bw, "v %v %v %v\n", formatFloat(p[0]), formatFloat(p[1]), formatFloat(p[2]))
+	}
+	for i := 0; i < len(m.UVs); i += 2 {
+		fmt.Fprintf(bw, "vt %v %v\n", formatFloat(m.UVs[i]), formatFloat(m.UVs[i+1]))
+	}
+	for i := 0; i < len(m.Normals); i += 3 {
+		fmt.Fprintf(bw, "vn %v %v %v\n", formatFloat(m.Normals[i]), formatFloat(m.Normals[i+1]), formatFloat(m.Normals[i+2]))
+	}
+	for i := 0; i < len(m.Indices); i += 3 {
+		bw.WriteString("f")
+		for _, index := range m.Indices[i : i+3] {
+			ref := strconv.Itoa(index + 1)
+			switch {
+			case m.UVs != nil && m.Normals != nil:
+				ref = ref + "/" + ref + "/" + ref
+			case m.UVs != nil:
+				ref = ref + "/" + ref
+			case m.Normals != nil:
+				ref = ref + "//" + ref
+			}
+			bw.WriteString(" " + ref)
+		}
+		bw.WriteString("\n")
+	}
+	return bw.Flush()
+}
+
+// formatFloat formats a number in the shortest form which parses back exactly
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
@@ -0,0 +1,125 @@
+package glmatrix
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestReadOBJ(t *testing.T) {
+	src := `# a textured quad
+mtllib quad.mtl
+o quad
+v 0 0 0
+v 1 0 0
+v 1 1 0 0.5 0.5 0.5
+v 0 1 0
+vt 0 0
+vt 1 0
+vt 1 1
+vt 0 1
+vn 0 0 1
+usemtl default
+s off
+f 1/1/1 2/2/1 3/3/1 4/4/1
+f -4/-4/-1 -2/-2/-1 -1/-1/-1 # the same quad again
+`
+	m, err := ReadOBJ(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("read obj: %v", err)
+	}
+	expect := testQuadMesh()
+	if !testSlice(m.Positions, expect.Positions) || !testSlice(m.Normals, expect.Normals) || !testSlice(m.UVs, expect.UVs) {
+		t.Errorf("read obj vertices: %v", m)
+	}
+	if !testIntSlice(m.Indices, []int{0, 1, 2, 0, 2, 3, 0, 2, 3}) {
+		t.Errorf("read obj indices: %v", m.Indices)
+	}
+}
+
+func TestReadOBJAttributes(t *testing.T) {
+	m, err := ReadOBJ(strings.NewReader("v 0 0 0\nv 1 0 0\nv 0 1 0\nf 1 2 3\n"))
+	if err != nil || m.Normals != nil || m.UVs != nil || !testIntSlice(m.Indices, []int{0, 1, 2}) {
+		t.Errorf("read obj positions: %v %v", m, err)
+	}
+	m, err = ReadOBJ(strings.NewReader("v 0 0 0\nv 1 0 0\nv 0 1 0\nvn 0 0 1\nf 1//1 2//1 3\n"))
+	if err != nil || m.UVs != nil || !testSlice(m.Normals, []float64{0, 0, 1, 0, 0, 1, 0, 0, 0}) {
+		t.Errorf("read obj normals: %v %v", m, err)
+	}
+	m, err = ReadOBJ(strings.NewReader("v 0 0 0\nv 1 0 0\nv 0 1 0\nvt 0.5\nf 1/1 2/1 3/1\n"))
+	if err != nil || m.Normals != nil || !testSlice(m.UVs, []float64{0.5, 0, 0.5, 0, 0.5, 0}) {
+		t.Errorf("read obj uvs: %v %v", m, err)
+	}
+	m, err = ReadOBJ(strings.NewReader(""))
+	if err != nil || m.VertexCount() != 0 || m.TriangleCount() != 0 {
+		t.Errorf("read obj empty: %v %v", m, err)
+	}
+}
+
+func TestReadOBJErrors(t *testing.T) {
+	cases := map[string]string{
+		"v 1 2\n":                        "line 1: v needs 3 values but has 2",
+		"v 1 2 x\n":                      `line 1: invalid number "x"`,
+		"v 0 0 0\nf 1 1\n":               "line 2: face needs 3 vertices but has 2",
+		"v 0 0 0\nf 1 1 2\n":             `line 2: vertex "2" refers to position attributes of which 1 are defined`,
+		"v 0 0 0\nf 1 1 1/1\n":           `line 2: vertex "1/1" refers to uv attributes of which 0 are defined`,
+		"v 0 0 0\nf 1 1 -2\n":            `vertex "-2" refers to position`,
+		"v 0 0 0\nf 1 1 1/1/1/1\n":       `invalid vertex "1/1/1/1"`,
+		"v 0 0 0\nf 1 1 a\n":             `invalid vertex "a"`,
+		"v 0 0 0\nvn 0 0 1\nf 1 1 //1\n": `invalid vertex "//1"`,
+	}
+	for src, expect := range cases {
+		m, err := ReadOBJ(strings.NewReader(src))
+		if m != nil || err == nil || !strings.Contains(err.Error(), expect) {
+			t.Errorf("read obj %q: %v", src, err)
+		}
+	}
+}
+
+func TestWriteOBJ(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteOBJ(&buf, testQuadMesh()); err != nil {
+		t.Fatalf("write obj: %v", err)
+	}
+	expect := "v 0 0 0\nv 1 0 0\nv 1 1 0\nv 0 1 0\n" +
+		"vt 0 0\nvt 1 0\nvt 1 1\nvt 0 1\n" +
+		"vn 0 0 1\nvn 0 0 1\nvn 0 0 1\nvn 0 0 1\n" +
+		"f 1/1/1 2/2/2 3/3/3\nf 1/1/1 3/3/3 4/4/4\n"
+	if buf.String() != expect {
+		t.Errorf("write obj: %v", buf.String())
+	}
+
+	m := testQuadMesh()
+	m.UVs = nil
+	buf.Reset()
+	WriteOBJ(&buf, m)
+	if !strings.Contains(buf.String(), "f 1//1 2//2 3//3\n") {
+		t.Errorf("write obj normals: %v", buf.String())
+	}
+	m.Normals = nil
+	m.UVs = testQuadMesh().UVs
+	buf.Reset()
+	WriteOBJ(&buf, m)
+	if !strings.Contains(buf.String(), "f 1/1 2/2 3/3\n") {
+		t.Errorf("write obj uvs: %v", buf.String())
+	}
+
+	m.Indices = []int{0, 1, 4}
+	if err := WriteOBJ(&buf, m); err == nil {
+		t.Errorf("write obj invalid: %v", err)
+	}
+}
+
+func TestOBJRoundTrip(t *testing.T) {
+	m := testQuadMesh()
+	m.Transform(Mat4FromZRotation(Mat4Create(), 0.3))
+	var buf bytes.Buffer
+	if err := WriteOBJ(&buf, m); err != nil {
+		t.Fatalf("write obj: %v", err)
+	}
+	actual, err := ReadOBJ(&buf)
+	if err != nil || !testSlice(actual.Positions, m.Positions) || !testSlice(actual.Normals, m.Normals) ||
+		!testSlice(actual.UVs, m.UVs) || !testIntSlice(actual.Indices, m.Indices) {
+		t.Errorf("obj round trip: %v %v", actual, err)
+	}
+}
@@ -0,0 +1,358 @@
+package glmatrix
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// plyTypes are the sizes in bytes of the scalar types of PLY properties
+var plyTypes = map[string]int{
+	"char": 1, "int8": 1, "uchar": 1, "uint8": 1,
+	"short": 2, "int16": 2, "ushort": 2, "uint16": 2,
+	"int": 4, "int32": 4, "uint": 4, "uint32": 4,
+	"float": 4, "float32": 4, "double": 8, "float64": 8,
+}
+
+// plyUVNames are the pairs of property names used for texture coordinates
+var plyUVNames = [][2]string{{"u", "v"}, {"s", "t"}, {"texture_u", "texture_v"}, {"texture_s", "texture_t"}}
+
+type plyProperty struct {
+	name      string
+	typ       string
+	countType string // the type of the length of a list property, or empty
+}
+
+type plyElement struct {
+	name       string
+	count      int
+	properties []plyProperty
+}
+
+// plyReader reads the values of the body of a PLY file
+type plyReader struct {
+	r       *bufio.Reader
+	order   binary.ByteOrder // nil for ASCII
+	scanner *bufio.Scanner
+	buf     [8]byte
+}
+
+func (p *plyReader) read(typ string) (float64, error) {
+	if p.order == nil {
+		if !p.scanner.Scan() {
+			if err := p.scanner.Err(); err != nil {
+				return 0, err
+			}
+			return 0, io.ErrUnexpectedEOF
+		}
+		v, err := strconv.ParseFloat(p.scanner.Text(), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid number %q", p.scanner.Text())
+		}
+		return v, nil
+	}
+	b := p.buf[:plyTypes[typ]]
+	if _, err := io.ReadFull(p.r, b); err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return 0, err
+	}
+	switch typ {
+	case "char", "int8":
+		return float64(int8(b[0])), nil
+	case "uchar", "uint8":
+		return float64(b[0]), nil
+	case "short", "int16":
+		return float64(int16(p.order.Uint16(b))), nil
+	case "ushort", "uint16":
+		return float64(p.order.Uint16(b)), nil
+	case "int", "int32":
+		return float64(int32(p.order.Uint32(b))), nil
+	case "uint", "uint32":
+		return float64(p.order.Uint32(b)), nil
+	case "float", "float32":
+		return float64(math.Float32frombits(p.order.Uint32(b))), nil
+	}
+	return math.Float64frombits(p.order.Uint64(b)), nil
+}
+
+// ReadPLY reads the vertex and face elements of an ASCII or binary PLY file
+// into a mesh. The positions are the x, y and z properties, the normals the nx,
+// ny and nz properties and the uvs the u and v properties or their s and t,
+// texture_u and texture_v, or texture_s and texture_t variants. Faces must have
+// a vertex_indices or vertex_index list and are split into triangle fans.
+// Other elements and properties are skipped.
+func ReadPLY(r io.Reader) (*Mesh, error) {
+	br := bufio.NewReader(r)
+	errorf := func(format string, args ...interface{}) error {
+		return fmt.Errorf("glmatrix: invalid PLY: %v", fmt.Sprintf(format, args...))
+	}
+
+	var elements []*plyElement
+	var order binary.ByteOrder
+	format := ""
+	for line := 1; ; line++ {
+		text, err := br.ReadString('\n')
+		if err != nil {
+			if err == io.EOF && line == 1 {
+				return nil, errorf("missing ply magic number")
+			}
+			if err == io.EOF {
+				return nil, errorf("missing end_header")
+			}
+			return nil, err
+		}
+		fields := strings.Fields(text)
+		if line == 1 {
+			if len(fields) != 1 || fields[0] != "ply" {
+				return nil, errorf("missing ply magic number")
+			}
+			continue
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		invalid := errorf("invalid header line %v: %q", line, strings.TrimSpace(text))
+		switch fields[0] {
+		case "format":
+			if len(fields) != 3 {
+				return nil, invalid
+			}
+			switch fields[1] {
+			case "ascii":
+			case "binary_little_endian":
+				order = binary.LittleEndian
+			case "binary_big_endian":
+				order = binary.BigEndian
+			default:
+				return nil, errorf("unknown format %q", fields[1])
+			}
+			format = fields[1]
+		case "element":
+			if len(fields) != 3 {
+				return nil, invalid
+			}
+			count, err := strconv.Atoi(fields[2])
+			if err != nil || count < 0 {
+				return nil, invalid
+			}
+			elements = append(elements, &plyElement{name: fields[1], count: count})
+		case "property":
+			if len(elements) == 0 {
+				return nil, invalid
+			}
+			e := elements[len(elements)-1]
+			var prop plyProperty
+			if len(fields) == 5 && fields[1] == "list" {
+				prop = plyProperty{name: fields[4], typ: fields[3], countType: fields[2]}
+				if _, ok := plyTypes[prop.countType]; !ok {
+					return nil, errorf("unknown type %q of property %v", prop.countType, prop.name)
+				}
+			} else if len(fields) == 3 {
+				prop = plyProperty{name: fields[2], typ: fields[1]}
+			} else {
+				return nil, invalid
+			}
+			if _, ok := plyTypes[prop.typ]; !ok {
+				return nil, errorf("unknown type %q of property %v", prop.typ, prop.name)
+			}
+			e.properties = append(e.properties, prop)
+		case "comment", "obj_info":
+		case "end_header":
+			if format == "" {
+				return nil, errorf("missing format")
+			}
+			return readPLYBody(br, order, elements, errorf)
+		default:
+			return nil, invalid
+		}
+	}
+}
+
+func readPLYBody(br *bufio.Reader, order binary.ByteOrder, elements []*plyElement, errorf func(string, ...interface{}) error) (*Mesh, error) {
+	p := &plyReader{r: br, order: order}
+	if order == nil {
+		p.scanner = bufio.NewScanner(br)
+		p.scanner.Split(bufio.ScanWords)
+	}
+	m := &Mesh{Positions: []float64{}, Indices: []int{}}
+	// faces may come before the vertices, so their count is taken from the header
+	vertexCount := 0
+	for _, e := range elements {
+		if e.name == "vertex" {
+			vertexCount = e.count
+		}
+	}
+	for _, e := range elements {
+		// the indices of the attributes in the properties of the element
+		attributes := map[string]int{}
+		for i, prop := range e.properties {
+			attributes[prop.name] = i
+		}
+		columns := func(names ...string) []int {
+			indices := make([]int, len(names))
+			for i, name := range names {
+				index, ok := attributes[name]
+				if !ok || e.properties[index].countType != "" {
+					return nil
+				}
+				indices[i] = index
+			}
+			return indices
+		}
+		var position, normal, uv []int
+		faceIndices := -1
+		switch e.name {
+		case "vertex":
+			if position = columns("x", "y", "z"); position == nil {
+				return nil, errorf("vertex element must have x, y and z properties")
+			}
+			normal = columns("nx", "ny", "nz")
+			if normal != nil {
+				m.Normals = []float64{}
+			}
+			for _, names := range plyUVNames {
+				if uv = columns(names[0], names[1]); uv != nil {
+					m.UVs = []float64{}
+					break
+				}
+			}
+		case "face":
+			for _, name := range []string{"vertex_indices", "vertex_index"} {
+				if i, ok := attributes[name]; ok && e.properties[i].countType != "" {
+					faceIndices = i
+					break
+				}
+			}
+			if faceIndices < 0 {
+				return nil, errorf("face element must have a vertex_indices list property")
+			}
+		}
+
+		values := make([]float64, len(e.properties))
+		var polygon []int
+		for i := 0; i < e.count; i++ {
+			for j, prop := range e.properties {
+				if prop.countType == "" {
+					v, err := p.read(prop.typ)
+					if err != nil {
+						return nil, errorf("%v %v of %v: %v", e.name, i, e.count, err)
+					}
+					values[j] = v
+					continue
+				}
+				n, err := p.read(prop.countType)
+				if err != nil {
+					return nil, errorf("%v %v of %v: %v", e.name, i, e.count, err)
+				}
+				if n < 0 || n != math.Trunc(n) {
+					return nil, errorf("%v %v of %v: invalid list length %v", e.name, i, e.count, n)
+				}
+				if j == faceIndices {
+					polygon = polygon[:0]
+				}
+				for k := 0; k < int(n); k++ {
+					v, err := p.read(prop.typ)
+					if err != nil {
+						return nil, errorf("%v %v of %v: %v", e.name, i, e.count, err)
+					}
+					if j == faceIndices {
+						if v < 0 || v >= float64(vertexCount) || v != math.Trunc(v) {
+							return nil, errorf("face %v refers to vertex %v of %v", i, v, vertexCount)
+						}
+						polygon = append(polygon, int(v))
+					}
+				}
+			}
+			switch e.name {
+			case "vertex":
+				for _, k := range position {
+					m.Positions = append(m.Positions, values[k])
+				}
+				for _, k := range normal {
+					m.Normals = append(m.Normals, values[k])
+				}
+				for _, k := range uv {
+					m.UVs = append(m.UVs, values[k])
+				}
+			case "face":
+				for k := 2; k < len(polygon); k++ {
+					m.Indices = append(m.Indices, polygon[0], polygon[k-1], polygon[k])
+				}
+			}
+		}
+	}
+	return m, nil
+}
+
+// WritePLY writes a mesh as a PLY file with float vertex properties and
+// a uchar int vertex_indices list per triangle. The binary encoding is
+// little-endian.
+func WritePLY(w io.Writer, m *Mesh, encoding MeshEncoding) error {
+	checkEncoding(encoding)
+	if err := m.validate(); err != nil {
+		return err
+	}
+	if m.VertexCount() > math.MaxInt32 {
+		return fmt.Errorf("glmatrix: %v vertices are too many for the int indices of PLY", m.VertexCount())
+	}
+	bw := bufio.NewWriter(w)
+	format := "ascii"
+	if encoding == MeshBinary {
+		format = "binary_little_endian"
+	}
+	fmt.Fprintf(bw, "ply\nformat %v 1.0\nelement vertex %v\n", format, m.VertexCount())
+	names := []string{"x", "y", "z"}
+	if m.Normals != nil {
+		names = append(names, "nx", "ny", "nz")
+	}
+	if m.UVs != nil {
+		names = append(names, "u", "v")
+	}
+	for _, name := range names {
+		fmt.Fprintf(bw, "property float %v\n", name)
+	}
+	fmt.Fprintf(bw, "element face %v\nproperty list uchar int vertex_indices\nend_header\n", m.TriangleCount())
+
+	values := make([]float64, 0, len(names))
+	var buf []byte
+	for i := 0; i < m.VertexCount(); i++ {
+		values = append(values[:0], m.Positions[i*3:i*3+3]...)
+		if m.Normals != nil {
+			values = append(values, m.Normals[i*3:i*3+3]...)
+		}
+		if m.UVs != nil {
+			values = append(values, m.UVs[i*2:i*2+2]...)
+		}
+		if encoding == MeshBinary {
+			buf = BinaryFormat{Float32: true}.Append(buf[:0], values)
+			bw.Write(buf)
+			continue
+		}
+		for j, v := range values {
+			if j > 0 {
+				bw.WriteByte(' ')
+			}
+			bw.WriteString(strconv.FormatFloat(float64(float32(v)), 'g', -1, 32))
+		}
+		bw.WriteByte('\n')
+	}
+	for i := 0; i < len(m.Indices); i += 3 {
+		if encoding == MeshBinary {
+			var face [13]byte
+			face[0] = 3
+			for j, index := range m.Indices[i : i+3] {
+				binary.LittleEndian.PutUint32(face[1+j*4:], uint32(index))
+			}
+			bw.Write(face[:])
+			continue
+		}
+		fmt.Fprintf(bw, "3 %v %v %v\n", m.Indices[i], m.Indices[i+1], m.Indices[i+2])
+	}
+	return bw.Flush()
+}
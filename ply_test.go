@@ -0,0 +1,178 @@
+package glmatrix
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestReadPLY(t *testing.T) {
+	src := `ply
+format ascii 1.0
+comment a textured quad
+element vertex 4
+property float x
+property float y
+property float z
+property uchar red
+property float nx
+property float ny
+property float nz
+property float s
+property float t
+element face 1
+property uchar flags
+property list uchar int vertex_indices
+element edge 1
+property int vertex1
+property int vertex2
+end_header
+0 0 0 255 0 0 1 0 0
+1 0 0 255 0 0 1 1 0
+1 1 0 255 0 0 1 1 1
+0 1 0 255 0 0 1 0 1
+7 4 0 1 2 3
+0 1
+`
+	m, err := ReadPLY(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("read ply: %v", err)
+	}
+	expect := testQuadMesh()
+	if !testSlice(m.Positions, expect.Positions) || !testSlice(m.Normals, expect.Normals) ||
+		!testSlice(m.UVs, expect.UVs) || !testIntSlice(m.Indices, expect.Indices) {
+		t.Errorf("read ply: %v", m)
+	}
+}
+
+func TestReadPLYBigEndian(t *testing.T) {
+	var buf bytes.Buffer
+	buf.WriteString("ply\r\nformat binary_big_endian 1.0\r\nelement vertex 3\r\nproperty double x\r\nproperty double y\r\n" +
+		"property double z\r\nelement face 1\r\nproperty list uchar ushort vertex_index\r\nend_header\r\n")
+	for _, v := range []float64{0, 0, 0, 1, 0, 0, 0, 1, 0} {
+		binary.Write(&buf, binary.BigEndian, math.Float64bits(v))
+	}
+	buf.Write([]byte{3, 0, 0, 0, 1, 0, 2})
+	m, err := ReadPLY(&buf)
+	if err != nil || m.Normals != nil || m.UVs != nil ||
+		!testSlice(m.Positions, []float64{0, 0, 0, 1, 0, 0, 0, 1, 0}) || !testIntSlice(m.Indices, []int{0, 1, 2}) {
+		t.Errorf("read ply big endian: %v %v", m, err)
+	}
+}
+
+func TestReadPLYTrailingList(t *testing.T) {
+	src := `ply
+format ascii 1.0
+element vertex 3
+property float x
+property float y
+property float z
+element face 1
+property list uchar int vertex_indices
+property list uchar float texcoord
+end_header
+0 0 0
+1 0 0
+0 1 0
+3 0 1 2 6 0 0 1 0 0 1
+`
+	m, err := ReadPLY(strings.NewReader(src))
+	if err != nil || !testIntSlice(m.Indices, []int{0, 1, 2}) {
+		t.Errorf("read ply trailing list: %v %v", m, err)
+	}
+}
+
+func TestReadPLYFaceFirst(t *testing.T) {
+	src := `ply
+format ascii 1.0
+element face 1
+property list uchar int vertex_indices
+element vertex 3
+property float x
+property float y
+property float z
+end_header
+3 0 1 2
+0 0 0
+1 0 0
+0 1 0
+`
+	m, err := ReadPLY(strings.NewReader(src))
+	if err != nil || !testIntSlice(m.Indices, []int{0, 1, 2}) || !testSlice(m.Positions, []float64{0, 0, 0, 1, 0, 0, 0, 1, 0}) {
+		t.Errorf("read ply face first: %v %v", m, err)
+	}
+}
+
+func TestReadPLYErrors(t *testing.T) {
+	header := "ply\nformat ascii 1.0\nelement vertex 3\nproperty float x\nproperty float y\nproperty float z\n" +
+		"element face 1\nproperty list uchar int vertex_indices\nend_header\n"
+	cases := map[string]string{
+		"":                         "missing ply magic number",
+		"ply\nformat ascii 1.0\n":  "missing end_header",
+		"ply\nend_header\n":        "missing format",
+		"ply\nformat binary 1.0\n": `unknown format "binary"`,
+		"ply\nproperty float x\n":  "invalid header line 2",
+		"ply\nelement vertex -1\n": "invalid header line 2",
+		"ply\nelement vertex 1\nproperty half x\n":                                   `unknown type "half" of property x`,
+		"ply\nformat ascii 1.0\nelement vertex 1\nproperty float x\nend_header\n1\n": "vertex element must have x, y and z properties",
+		"ply\nformat ascii 1.0\nelement face 0\nproperty int flags\nend_header\n":    "face element must have a vertex_indices list property",
+		header + "0 0 0\n1 0 0\n0 1 0\n3 0 1 3\n":                                    "face 0 refers to vertex 3 of 3",
+		header + "0 0 0\n1 0 0\n0 1 0\n3 0 1\n":                                      "face 0 of 1: unexpected EOF",
+		header + "0 0 0\n1 0 0\n0 1\n":                                               "vertex 2 of 3: unexpected EOF",
+		header + "0 0 0\n1 0 0\n0 1 x\n":                                             `vertex 2 of 3: invalid number "x"`,
+		header + "0 0 0\n1 0 0\n0 1 0\n-3 0 1 2\n":                                   "face 0 of 1: invalid list length -3",
+	}
+	for src, expect := range cases {
+		m, err := ReadPLY(strings.NewReader(src))
+		if m != nil || err == nil || !strings.Contains(err.Error(), expect) {
+			t.Errorf("read ply %q: %v", src, err)
+		}
+	}
+}
+
+func TestWritePLY(t *testing.T) {
+	var buf bytes.Buffer
+	m := testQuadMesh()
+	m.Positions[0] = 0.1
+	if err := WritePLY(&buf, m, MeshASCII); err != nil {
+		t.Fatalf("write ply: %v", err)
+	}
+	expect := "ply\nformat ascii 1.0\nelement vertex 4\n" +
+		"property float x\nproperty float y\nproperty float z\nproperty float nx\nproperty float ny\nproperty float nz\n" +
+		"property float u\nproperty float v\nelement face 2\nproperty list uchar int vertex_indices\nend_header\n" +
+		"0.1 0 0 0 0 1 0 0\n1 0 0 0 0 1 1 0\n1 1 0 0 0 1 1 1\n0 1 0 0 0 1 0 1\n3 0 1 2\n3 0 2 3\n"
+	if buf.String() != expect {
+		t.Errorf("write ply: %v", buf.String())
+	}
+
+	m.Normals = nil
+	m.UVs = nil
+	buf.Reset()
+	if err := WritePLY(&buf, m, MeshBinary); err != nil {
+		t.Fatalf("write ply binary: %v", err)
+	}
+	data := buf.Bytes()
+	header := "ply\nformat binary_little_endian 1.0\nelement vertex 4\n" +
+		"property float x\nproperty float y\nproperty float z\nelement face 2\nproperty list uchar int vertex_indices\nend_header\n"
+	if !strings.HasPrefix(string(data), header) || len(data) != len(header)+4*12+2*13 {
+		t.Errorf("write ply binary: %q", data)
+	}
+}
+
+func TestPLYRoundTrip(t *testing.T) {
+	for _, encoding := range []MeshEncoding{MeshASCII, MeshBinary} {
+		m := testQuadMesh()
+		m.Transform(Mat4FromZRotation(Mat4Create(), 0.3))
+		var buf bytes.Buffer
+		if err := WritePLY(&buf, m, encoding); err != nil {
+			t.Fatalf("write ply %v: %v", encoding, err)
+		}
+		actual, err := ReadPLY(&buf)
+		if err != nil || !testSliceTol(actual.Positions, m.Positions, 1e-6) || !testSliceTol(actual.Normals, m.Normals, 1e-6) ||
+			!testSliceTol(actual.UVs, m.UVs, 1e-6) || !testIntSlice(actual.Indices, m.Indices) {
+			t.Errorf("ply round trip %v: %v %v", encoding, actual, err)
+		}
+	}
+}
@@ -0,0 +1,315 @@
+package glmatrix
+
+import "math"
+
+// The primitives are centered at the origin with y up and have
+// counter-clockwise triangles seen from outside. Each constructor takes an
+// optional mat4 applied with Mesh.Transform, which may be nil. Segment counts
+// below the minimum of a primitive are raised to it.
+
+// NewBoxMesh creates a box with the given vec3 size. Each face has its own
+// four vertices so that the normals are flat, with uvs covering the face.
+func NewBoxMesh(size, transform []float64) *Mesh {
+	m := newMesh()
+	x, y, z := size[0], size[1], size[2]
+	faces := [][3][]float64{
+		// normal, u and v edges, which are along the sides of the face with u x v = normal
+		{{1, 0, 0}, {0, 0, -z}, {0, y, 0}},
+		{{-1, 0, 0}, {0, 0, z}, {0, y, 0}},
+		{{0, 1, 0}, {x, 0, 0}, {0, 0, -z}},
+		{{0, -1, 0}, {x, 0, 0}, {0, 0, z}},
+		{{0, 0, 1}, {x, 0, 0}, {0, y, 0}},
+		{{0, 0, -1}, {-x, 0, 0}, {0, y, 0}},
+	}
+	for _, f := range faces {
+		n, u, v := f[0], f[1], f[2]
+		origin := Vec3Multiply(Vec3Create(), n, []float64{x / 2, y / 2, z / 2})
+		Vec3ScaleAndAdd(origin, origin, u, -0.5)
+		Vec3ScaleAndAdd(origin, origin, v, -0.5)
+		meshGrid(m, origin, u, v, n, 1, 1)
+	}
+	return finishPrimitive(m, transform)
+}
+
+// NewPlaneMesh creates a grid on the xz plane facing +y with the given width
+// along x and depth along z, divided into at least one segment in each direction.
+// The uvs go from 0 to 1 along +x and -z.
+func NewPlaneMesh(width, depth float64, widthSegments, depthSegments int, transform []float64) *Mesh {
+	m := newMesh()
+	meshGrid(m, []float64{-width / 2, 0, depth / 2}, []float64{width, 0, 0}, []float64{0, 0, -depth}, []float64{0, 1, 0},
+		maxInt(widthSegments, 1), maxInt(depthSegments, 1))
+	return finishPrimitive(m, transform)
+}
+
+// NewUVSphereMesh creates a sphere with at least 3 segments around the y axis
+// and 2 rings from pole to pole. The u coordinate goes around from +z toward
+// +x and the v coordinate from the bottom to the top.
+func NewUVSphereMesh(radius float64, segments, rings int, transform []float64) *Mesh {
+	m := newMesh()
+	rings = maxInt(rings, 2)
+	profile := make([]revolutionPoint, rings+1)
+	for i := range profile {
+		phi := float64(i) / float64(rings) * math.Pi
+		sin := math.Sin(phi)
+		if i == rings {
+			sin = 0 // exactly a pole rather than sin(pi)
+		}
+		profile[i] = revolutionPoint{
+			radius: radius * sin, y: -radius * math.Cos(phi),
+			normalRadius: sin, normalY: -math.Cos(phi),
+			v: float64(i) / float64(rings),
+		}
+	}
+	revolve(m, maxInt(segments, 3), profile)
+	return finishPrimitive(m, transform)
+}
+
+// NewIcosphereMesh creates a sphere by subdividing an icosahedron, whose
+// triangles have about the same size, the given number of times. The uvs are
+// spherical coordinates like NewUVSphereMesh, which are not continuous across
+// the seam at -z where u wraps around.
+func NewIcosphereMesh(radius float64, subdivisions int, transform []float64) *Mesh {
+	t := (1 + math.Sqrt(5)) / 2
+	positions := [][]float64{
+		{-1, t, 0}, {1, t, 0}, {-1, -t, 0}, {1, -t, 0},
+		{0, -1, t}, {0, 1, t}, {0, -1, -t}, {0, 1, -t},
+		{t, 0, -1}, {t, 0, 1}, {-t, 0, -1}, {-t, 0, 1},
+	}
+	indices := []int{
+		0, 11, 5, 0, 5, 1, 0, 1, 7, 0, 7, 10, 0, 10, 11,
+		1, 5, 9, 5, 11, 4, 11, 10, 2, 10, 7, 6, 7, 1, 8,
+		3, 9, 4, 3, 4, 2, 3, 2, 6, 3, 6, 8, 3, 8, 9,
+		4, 9, 5, 2, 4, 11, 6, 2, 10, 8, 6, 7, 9, 8, 1,
+	}
+	for _, p := range positions {
+		Vec3Normalize(p, p)
+	}
+	for s := 0; s < subdivisions; s++ {
+		midpoints := map[[2]int]int{}
+		midpoint := func(a, b int) int {
+			key := [2]int{a, b}
+			if b < a {
+				key = [2]int{b, a}
+			}
+			if index, ok := midpoints[key]; ok {
+				return index
+			}
+			p := Vec3Add(Vec3Create(), positions[a], positions[b])
+			positions = append(positions, Vec3Normalize(p, p))
+			midpoints[key] = len(positions) - 1
+			return len(positions) - 1
+		}
+		next := make([]int, 0, len(indices)*4)
+		for i := 0; i < len(indices); i += 3 {
+			a, b, c := indices[i], indices[i+1], indices[i+2]
+			ab, bc, ca := midpoint(a, b), midpoint(b, c), midpoint(c, a)
+			next = append(next, a, ab, ca, b, bc, ab, c, ca, bc, ab, bc, ca)
+		}
+		indices = next
+	}
+
+	m := newMesh()
+	for _, p := range positions {
+		m.Positions = append(m.Positions, p[0]*radius, p[1]*radius, p[2]*radius)
+		m.Normals = append(m.Normals, p[0], p[1], p[2])
+		u := math.Atan2(p[0], p[2]) / (2 * math.Pi)
+		if u < 0 {
+			u++
+		}
+		m.UVs = append(m.UVs, u, 0.5+math.Asin(math.Max(-1, math.Min(1, p[1])))/math.Pi)
+	}
+	m.Indices = indices
+	return finishPrimitive(m, transform)
+}
+
+// NewCylinderMesh creates a cylinder along the y axis with at least 3
+// segments around it, closed by flat caps
+func NewCylinderMesh(radius, height float64, segments int, transform []float64) *Mesh {
+	return newFrustumMesh(radius, radius, height, segments, transform)
+}
+
+// NewConeMesh creates a cone along the y axis with its apex at the top and
+// at least 3 segments around it, closed by a flat base
+func NewConeMesh(radius, height float64, segments int, transform []float64) *Mesh {
+	return newFrustumMesh(radius, 0, height, segments, transform)
+}
+
+func newFrustumMesh(bottom, top, height float64, segments int, transform []float64) *Mesh {
+	m := newMesh()
+	segments = maxInt(segments, 3)
+	// the normal of the side is perpendicular to its slope
+	slope := Vec2Normalize(Vec2Create(), []float64{height, bottom - top})
+	revolve(m, segments, []revolutionPoint{
+		{radius: bottom, y: -height / 2, normalRadius: slope[0], normalY: slope[1], v: 0},
+		{radius: top, y: height / 2, normalRadius: slope[0], normalY: slope[1], v: 1},
+	})
+	meshDisc(m, bottom, -height/2, segments, false)
+	if top > 0 {
+		meshDisc(m, top, height/2, segments, true)
+	}
+	return finishPrimitive(m, transform)
+}
+
+// NewTorusMesh creates a torus around the y axis whose tube of the given
+// radius follows a circle of the given radius, with at least 3 segments
+// around the y axis and 3 around the tube
+func NewTorusMesh(radius, tube float64, segments, tubeSegments int, transform []float64) *Mesh {
+	m := newMesh()
+	tubeSegments = maxInt(tubeSegments, 3)
+	profile := make([]revolutionPoint, tubeSegments+1)
+	for i := range profile {
+		phi := float64(i) / float64(tubeSegments) * 2 * math.Pi
+		profile[i] = revolutionPoint{
+			radius: radius + tube*math.Cos(phi), y: tube * math.Sin(phi),
+			normalRadius: math.Cos(phi), normalY: math.Sin(phi),
+			v: float64(i) / float64(tubeSegments),
+		}
+	}
+	revolve(m, maxInt(segments, 3), profile)
+	return finishPrimitive(m, transform)
+}
+
+// NewCapsuleMesh creates a capsule along the y axis made of a cylinder of the
+// given length closed by two hemispheres, with at least 3 segments around the
+// y axis and 1 ring in each hemisphere. The v coordinate is proportional to
+// the distance along the surface from the bottom.
+func NewCapsuleMesh(radius, length float64, segments, rings int, transform []float64) *Mesh {
+	m := newMesh()
+	rings = maxInt(rings, 1)
+	total := math.Pi*radius + length
+	profile := make([]revolutionPoint, 0, rings*2+2)
+	for half := 0; half < 2; half++ {
+		y := (float64(half) - 0.5) * length
+		for i := 0; i <= rings; i++ {
+			phi := float64(half*rings+i) / float64(rings) * math.Pi / 2
+			arc := phi*radius + float64(half)*length
+			sin := math.Sin(phi)
+			if half == 1 && i == rings {
+				sin = 0 // exactly a pole rather than sin(pi)
+			}
+			profile = append(profile, revolutionPoint{
+				radius: radius * sin, y: y - radius*math.Cos(phi),
+				normalRadius: sin, normalY: -math.Cos(phi),
+				v: arc / total,
+			})
+		}
+	}
+	revolve(m, maxInt(segments, 3), profile)
+	return finishPrimitive(m, transform)
+}
+
+func newMesh() *Mesh {
+	return &Mesh{Positions: []float64{}, Normals: []float64{}, UVs: []float64{}, Indices: []int{}}
+}
+
+func finishPrimitive(m *Mesh, transform []float64) *Mesh {
+	if transform != nil {
+		m.Transform(transform)
+	}
+	return m
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// meshGrid appends a flat grid spanning the edges u and v from origin, whose
+// cross product must point along the normal
+func meshGrid(m *Mesh, origin, u, v, normal []float64, uSegments, vSegments int) {
+	start := m.VertexCount()
+	p := Vec3Create()
+	for j := 0; j <= vSegments; j++ {
+		t := float64(j) / float64(vSegments)
+		for i := 0; i <= uSegments; i++ {
+			s := float64(i) / float64(uSegments)
+			Vec3ScaleAndAdd(p, origin, u, s)
+			Vec3ScaleAndAdd(p, p, v, t)
+			m.Positions = append(m.Positions, p[0], p[1], p[2])
+			m.Normals = append(m.Normals, normal[0], normal[1], normal[2])
+			m.UVs = append(m.UVs, s, t)
+		}
+	}
+	meshQuads(m, start, uSegments, vSegments, nil)
+}
+
+// meshQuads appends two triangles for each cell of a grid of vertices with
+// uSegments + 1 vertices per row, skipping the triangles which would collapse
+// on the rows marked as poles, whose vertices are all at the same point. The
+// poles may be nil.
+func meshQuads(m *Mesh, start, uSegments, vSegments int, poles []bool) {
+	pole := func(j int) bool {
+		return poles != nil && poles[j]
+	}
+	row := uSegments + 1
+	for j := 0; j < vSegments; j++ {
+		for i := 0; i < uSegments; i++ {
+			a := start + j*row + i
+			b := a + 1
+			c := b + row
+			d := a + row
+			if !pole(j) {
+				m.Indices = append(m.Indices, a, b, c)
+			}
+			if !pole(j + 1) {
+				m.Indices = append(m.Indices, a, c, d)
+			}
+		}
+	}
+}
+
+// revolutionPoint is a point of the profile of a surface of revolution with
+// its normal and v coordinate
+type revolutionPoint struct {
+	radius, y             float64
+	normalRadius, normalY float64
+	v                     float64
+}
+
+// revolve appends the surface sweeping a profile around the y axis. The
+// profile must go upward on its outer side for the triangles to face outward.
+func revolve(m *Mesh, segments int, profile []revolutionPoint) {
+	start := m.VertexCount()
+	for _, p := range profile {
+		for i := 0; i <= segments; i++ {
+			s := float64(i) / float64(segments)
+			sin, cos := math.Sincos(s * 2 * math.Pi)
+			m.Positions = append(m.Positions, p.radius*sin, p.y, p.radius*cos)
+			m.Normals = append(m.Normals, p.normalRadius*sin, p.normalY, p.normalRadius*cos)
+			m.UVs = append(m.UVs, s, p.v)
+		}
+	}
+	poles := make([]bool, len(profile))
+	for j, p := range profile {
+		poles[j] = p.radius == 0
+	}
+	meshQuads(m, start, segments, len(profile)-1, poles)
+}
+
+// meshDisc appends a flat disc at height y facing up or down with uvs mapping
+// the disc into the unit square
+func meshDisc(m *Mesh, radius, y float64, segments int, up bool) {
+	ny := -1.
+	if up {
+		ny = 1
+	}
+	center := m.VertexCount()
+	m.Positions = append(m.Positions, 0, y, 0)
+	m.Normals = append(m.Normals, 0, ny, 0)
+	m.UVs = append(m.UVs, 0.5, 0.5)
+	for i := 0; i <= segments; i++ {
+		sin, cos := math.Sincos(float64(i) / float64(segments) * 2 * math.Pi)
+		m.Positions = append(m.Positions, radius*sin, y, radius*cos)
+		m.Normals = append(m.Normals, 0, ny, 0)
+		m.UVs = append(m.UVs, 0.5+0.5*sin, 0.5-0.5*ny*cos)
+	}
+	for i := 1; i <= segments; i++ {
+		if up {
+			m.Indices = append(m.Indices, center, center+i, center+i+1)
+		} else {
+			m.Indices = append(m.Indices, center, center+i+1, center+i)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package glmatrix
+
+import (
+	"math"
+	"testing"
+)
+
+// testPrimitive checks that a mesh is valid, has unit normals and uvs in the
+// unit square, and that its triangles wind counter-clockwise around the
+// vertex normals
+func testPrimitive(t *testing.T, name string, m *Mesh) {
+	if err := m.validate(); err != nil || m.Normals == nil || m.UVs == nil {
+		t.Fatalf("%v: %v", name, err)
+	}
+	for i := 0; i < m.VertexCount(); i++ {
+		if l := Vec3Length(m.Normals[i*3 : i*3+3]); !equals(l, 1) {
+			t.Errorf("%v: normal %v has length %v", name, i, l)
+		}
+		if u, v := m.UVs[i*2], m.UVs[i*2+1]; u < 0 || u > 1 || v < 0 || v > 1 {
+			t.Errorf("%v: uv %v is %v, %v", name, i, u, v)
+		}
+	}
+	n := Vec3Create()
+	for i := 0; i < m.TriangleCount(); i++ {
+		m.triangleNormal(n, i)
+		if Vec3SquaredLength(n) == 0 {
+			t.Errorf("%v: triangle %v is degenerate", name, i)
+		}
+		for _, index := range m.Indices[i*3 : i*3+3] {
+			if Vec3Dot(n, m.Normals[index*3:index*3+3]) <= 0 {
+				t.Errorf("%v: triangle %v faces away from the normal of vertex %v", name, i, index)
+			}
+		}
+	}
+}
+
+// testMeshRadius returns the minimum and maximum distance of the vertices from the y axis
+func testMeshRadius(m *Mesh) (float64, float64) {
+	min, max := math.Inf(1), 0.
+	for i := 0; i < len(m.Positions); i += 3 {
+		r := math.Hypot(m.Positions[i], m.Positions[i+2])
+		min = math.Min(min, r)
+		max = math.Max(max, r)
+	}
+	return min, max
+}
+
+func TestNewBoxMesh(t *testing.T) {
+	m := NewBoxMesh([]float64{2, 4, 6}, nil)
+	testPrimitive(t, "box", m)
+	if m.VertexCount() != 24 || m.TriangleCount() != 12 {
+		t.Errorf("box: %v vertices %v triangles", m.VertexCount(), m.TriangleCount())
+	}
+	for i := 0; i < len(m.Positions); i += 3 {
+		p := m.Positions[i : i+3]
+		if math.Abs(p[0]) != 1 || math.Abs(p[1]) != 2 || math.Abs(p[2]) != 3 {
+			t.Errorf("box: corner %v", p)
+		}
+	}
+}
+
+func TestNewPlaneMesh(t *testing.T) {
+	m := NewPlaneMesh(4, 2, 4, 0, nil)
+	testPrimitive(t, "plane", m)
+	if m.VertexCount() != 10 || m.TriangleCount() != 8 {
+		t.Errorf("plane: %v vertices %v triangles", m.VertexCount(), m.TriangleCount())
+	}
+	if !testSlice(m.Positions[0:3], []float64{-2, 0, 1}) || !testSlice(m.Positions[27:30], []float64{2, 0, -1}) ||
+		!testSlice(m.UVs[18:20], []float64{1, 1}) {
+		t.Errorf("plane: %v %v", m.Positions, m.UVs)
+	}
+}
+
+func TestNewUVSphereMesh(t *testing.T) {
+	m := NewUVSphereMesh(2, 8, 4, nil)
+	testPrimitive(t, "uv sphere", m)
+	if m.VertexCount() != 9*5 || m.TriangleCount() != 8*2*2+8*2 {
+		t.Errorf("uv sphere: %v vertices %v triangles", m.VertexCount(), m.TriangleCount())
+	}
+	for i := 0; i < m.VertexCount(); i++ {
+		p := m.Positions[i*3 : i*3+3]
+		if !equals(Vec3Length(p), 2) || !Vec3Equals(Vec3Scale(Vec3Create(), p, 0.5), m.Normals[i*3:i*3+3]) {
+			t.Errorf("uv sphere: vertex %v", p)
+		}
+	}
+}
+
+func TestNewIcosphereMesh(t *testing.T) {
+	for subdivisions, counts := range [][2]int{{12, 20}, {42, 80}, {162, 320}} {
+		m := NewIcosphereMesh(3, subdivisions, nil)
+		testPrimitive(t, "icosphere", m)
+		if m.VertexCount() != counts[0] || m.TriangleCount() != counts[1] {
+			t.Errorf("icosphere %v: %v vertices %v triangles", subdivisions, m.VertexCount(), m.TriangleCount())
+		}
+		for i := 0; i < len(m.Positions); i += 3 {
+			if !equals(Vec3Length(m.Positions[i:i+3]), 3) {
+				t.Errorf("icosphere %v: vertex %v", subdivisions, m.Positions[i:i+3])
+			}
+		}
+	}
+}
+
+func TestNewCylinderMesh(t *testing.T) {
+	m := NewCylinderMesh(1, 2, 6, nil)
+	testPrimitive(t, "cylinder", m)
+	if m.VertexCount() != 7*2+8*2 || m.TriangleCount() != 6*2+6*2 {
+		t.Errorf("cylinder: %v vertices %v triangles", m.VertexCount(), m.TriangleCount())
+	}
+	if !testSlice(m.Normals[0:3], []float64{0, 0, 1}) {
+		t.Errorf("cylinder: side normal %v", m.Normals[0:3])
+	}
+	if min, max := testMeshRadius(m); min != 0 || !equals(max, 1) {
+		t.Errorf("cylinder: radius %v %v", min, max)
+	}
+}
+
+func TestNewConeMesh(t *testing.T) {
+	m := NewConeMesh(1, 1, 2, nil)
+	testPrimitive(t, "cone", m)
+	if m.VertexCount() != 4*2+5 || m.TriangleCount() != 3+3 {
+		t.Errorf("cone: %v vertices %v triangles", m.VertexCount(), m.TriangleCount())
+	}
+	if !testSlice(m.Normals[0:3], []float64{0, math.Sqrt(0.5), math.Sqrt(0.5)}) {
+		t.Errorf("cone: side normal %v", m.Normals[0:3])
+	}
+}
+
+func TestPrimitiveMeshSmall(t *testing.T) {
+	if m := NewUVSphereMesh(1e-6, 8, 4, nil); m.TriangleCount() != 8*2*2+8*2 {
+		t.Errorf("small uv sphere: %v triangles", m.TriangleCount())
+	}
+	if m := NewCylinderMesh(1e-6, 1e-6, 8, nil); m.TriangleCount() != 8*2+8*2 {
+		t.Errorf("small cylinder: %v triangles", m.TriangleCount())
+	}
+	if m := NewConeMesh(1e-6, 1e-6, 8, nil); m.TriangleCount() != 8+8 {
+		t.Errorf("small cone: %v triangles", m.TriangleCount())
+	}
+	if m := NewCapsuleMesh(1e-7, 1e-7, 8, 2, nil); m.TriangleCount() != 8*2*5-8*2 {
+		t.Errorf("small capsule: %v triangles", m.TriangleCount())
+	}
+}
+
+func TestNewTorusMesh(t *testing.T) {
+	m := NewTorusMesh(3, 1, 12, 8, nil)
+	testPrimitive(t, "torus", m)
+	if m.VertexCount() != 13*9 || m.TriangleCount() != 12*8*2 {
+		t.Errorf("torus: %v vertices %v triangles", m.VertexCount(), m.TriangleCount())
+	}
+	if min, max := testMeshRadius(m); !equals(min, 2) || !equals(max, 4) {
+		t.Errorf("torus: radius %v %v", min, max)
+	}
+}
+
+func TestNewCapsuleMesh(t *testing.T) {
+	m := NewCapsuleMesh(1, 2, 8, 3, nil)
+	testPrimitive(t, "capsule", m)
+	if m.VertexCount() != 9*8 || m.TriangleCount() != 8*2*7-8*2 {
+		t.Errorf("capsule: %v vertices %v triangles", m.VertexCount(), m.TriangleCount())
+	}
+	minY, maxY := math.Inf(1), math.Inf(-1)
+	for i := 1; i < len(m.Positions); i += 3 {
+		minY = math.Min(minY, m.Positions[i])
+		maxY = math.Max(maxY, m.Positions[i])
+	}
+	if !equals(minY, -2) || !equals(maxY, 2) {
+		t.Errorf("capsule: height %v %v", minY, maxY)
+	}
+	if min, max := testMeshRadius(m); min != 0 || !equals(max, 1) {
+		t.Errorf("capsule: radius %v %v", min, max)
+	}
+}
+
+func TestPrimitiveTransform(t *testing.T) {
+	transform := Mat4FromTranslation(Mat4Create(), []float64{0, 5, 0})
+	Mat4RotateX(transform, transform, math.Pi/2)
+	m := NewCylinderMesh(1, 2, 8, transform)
+	testPrimitive(t, "transformed cylinder", m)
+	// the cylinder now lies along z around y = 5
+	for i := 0; i < len(m.Positions); i += 3 {
+		p := m.Positions[i : i+3]
+		if math.Hypot(p[0], p[1]-5) > 1+Epsilon || math.Abs(p[2]) > 1+Epsilon {
+			t.Errorf("transformed cylinder: vertex %v", p)
+		}
+	}
+}
@@ -202,7 +202,7 @@ func QuatLn(out, a []float64) []float64 {
 	r := math.Sqrt(x*x + y*y + z*z)
 	t := 0.
 	if r > 0 {
-		t = math.Atan2(r, w)
+		t = math.Atan2(r, w) / r
 	}
 	out[0] = x * t
 	out[1] = y * t
@@ -328,9 +328,9 @@ func QuatFromMat3(out, m []float64) []float64 {
 		fRoot := math.Sqrt(m[i*3+i] - m[j*3+j] - m[k*3+k] + 1.)
 		out[i] = 0.5 * fRoot
 		fRoot = 0.5 / fRoot
-		out[3] = (m[j*3+k] - m[k*3+j]) + fRoot
-		out[j] = (m[j*3+i] - m[i*3+j]) + fRoot
-		out[k] = (m[k*3+i] - m[i*3+k]) + fRoot
+		out[3] = (m[j*3+k] - m[k*3+j]) * fRoot
+		out[j] = (m[j*3+i] + m[i*3+j]) * fRoot
+		out[k] = (m[k*3+i] + m[i*3+k]) * fRoot
 	}
 	return out
 }
@@ -429,6 +429,9 @@ var QuatMul = QuatMultiply
 // QuatScale scales a quat by a scalar number
 var QuatScale = Vec4Scale
 
+// QuatScaleAndAdd adds two quat's after scaling the second operand by a scalar value
+var QuatScaleAndAdd = Vec4ScaleAndAdd
+
 // QuatDot calculates the dot product of two quat's
 var QuatDot = Vec4Dot
 
@@ -77,6 +77,46 @@ func TestQuatFromMat3(t *testing.T) {
 	}
 }
 
+func TestQuatFromMat3HalfTurn(t *testing.T) {
+	matr := []float64{
+		-1, 0, 0,
+		0, 1, 0,
+		0, 0, -1,
+	}
+	actual := QuatFromMat3(QuatCreate(), matr)
+	expect := []float64{0, 1, 0, 0}
+	if !testSlice(actual, expect) {
+		t.Errorf("from mat3 half turn: %v", actual)
+	}
+}
+
+func TestQuatFromMat3NegativeTrace(t *testing.T) {
+	// a rotation of 150 degrees about (1, 2, 3)
+	matr := []float64{
+		-0.732737875, 0.667466921, 0.132601345,
+		-0.134316805, -0.332875288, 0.933355794,
+		0.667123828, 0.666094552, 0.333562356,
+	}
+	actual := QuatFromMat3(QuatCreate(), matr)
+	expect := []float64{0.258154, 0.516309, 0.774463, 0.258819}
+	if !testSlice(actual, expect) {
+		t.Errorf("from mat3 negative trace: %v", actual)
+	}
+}
+
+func TestQuatLn(t *testing.T) {
+	actual := QuatLn(QuatCreate(), []float64{0, 0, 0.707106781, 0.707106781})
+	expect := []float64{0, 0, math.Pi / 4, 0}
+	if !testSlice(actual, expect) {
+		t.Errorf("ln: %v", actual)
+	}
+	actual = QuatLn(QuatCreate(), quatA)
+	expect = []float64{0.200991, 0.401982, 0.602973, 1.700598}
+	if !testSlice(actual, expect) {
+		t.Errorf("ln: %v", actual)
+	}
+}
+
 func TestQuatFromEuler(t *testing.T) {
 	actual := QuatFromEuler(QuatCreate(), -90, 0, 0)
 	expect := []float64{-0.707106, 0, 0, 0.707106}
@@ -0,0 +1,162 @@
+package glmatrix
+
+import (
+	"fmt"
+	"math"
+)
+
+// Quaternion sets are flat arrays of quat's. Weights are optional;
+// pass nil to weight every quaternion equally.
+
+// QuatAverage calculates the weighted average of unit quaternions by Markley's
+// method, i.e. the eigenvector of the largest eigenvalue of the sum of the
+// weighted outer products. The result does not depend on the order or on the
+// signs of the quaternions and minimizes the weighted sum of the squared
+// chordal distances of the rotation matrices.
+func QuatAverage(out, quats, weights []float64) []float64 {
+	checkQuatSet(quats, weights)
+	m := make([]float64, 16)
+	for i := 0; i < len(quats)/4; i++ {
+		w := 1.
+		if weights != nil {
+			w = weights[i]
+		}
+		q := quats[i*4 : i*4+4]
+		for r := 0; r < 4; r++ {
+			for c := 0; c < 4; c++ {
+				m[c*4+r] += w * q[r] * q[c]
+			}
+		}
+	}
+	values, vectors := symmetricEigen(m, 4)
+	k := 0
+	for i := 1; i < 4; i++ {
+		if values[i] > values[k] {
+			k = i
+		}
+	}
+	if values[k] <= 0 {
+		return QuatIdentity(out)
+	}
+	QuatNormalize(out, vectors[k*4:k*4+4])
+	if out[3] < 0 {
+		QuatScale(out, out, -1)
+	}
+	return out
+}
+
+// QuatChordalMean alias for QuatAverage
+var QuatChordalMean = QuatAverage
+
+// QuatGeodesicMean calculates the weighted Karcher mean of unit quaternions,
+// i.e. the rotation minimizing the weighted sum of the squared angular
+// distances. It is found iteratively starting from QuatAverage.
+func QuatGeodesicMean(out, quats, weights []float64) []float64 {
+	QuatAverage(out, quats, weights)
+	n := len(quats) / 4
+	inv := QuatCreate()
+	tmp := QuatCreate()
+	v := Vec3Create()
+	delta := Vec3Create()
+	for iter := 0; iter < 100; iter++ {
+		QuatConjugate(inv, out)
+		Vec3Zero(delta)
+		wsum := 0.
+		for i := 0; i < n; i++ {
+			w := 1.
+			if weights != nil {
+				w = weights[i]
+			}
+			QuatMultiply(tmp, inv, quats[i*4:i*4+4])
+			SO3LogQuat(v, tmp)
+			Vec3ScaleAndAdd(delta, delta, v, w)
+			wsum += w
+		}
+		if wsum <= 0 {
+			break
+		}
+		Vec3Scale(delta, delta, 1/wsum)
+		QuatMultiply(out, out, SO3ExpQuat(tmp, delta))
+		QuatNormalize(out, out)
+		if Vec3Length(delta) < 1e-12 {
+			break
+		}
+	}
+	if out[3] < 0 {
+		QuatScale(out, out, -1)
+	}
+	return out
+}
+
+// QuatAngularDistance calculates the angle in radians of the shortest rotation
+// between two unit quaternions. Unlike QuatGetAngle it stays accurate for
+// nearly identical rotations.
+func QuatAngularDistance(a, b []float64) float64 {
+	tmp := QuatCopy(QuatCreate(), b)
+	if QuatDot(a, b) < 0 {
+		QuatScale(tmp, tmp, -1)
+	}
+	diff := Vec4Distance(a, tmp)
+	sum := QuatLength(QuatAdd(tmp, a, tmp))
+	return 4 * math.Atan2(diff, sum)
+}
+
+// QuatVariance calculates the weighted mean of the squared angular distances
+// of unit quaternions from the given mean rotation
+func QuatVariance(mean, quats, weights []float64) float64 {
+	checkQuatSet(quats, weights)
+	sum := 0.
+	wsum := 0.
+	for i := 0; i < len(quats)/4; i++ {
+		w := 1.
+		if weights != nil {
+			w = weights[i]
+		}
+		d := QuatAngularDistance(mean, quats[i*4:i*4+4])
+		sum += w * d * d
+		wsum += w
+	}
+	if wsum <= 0 {
+		return 0
+	}
+	return sum / wsum
+}
+
+// QuatCovariance calculates the weighted 3x3 covariance of the rotation
+// vectors of unit quaternions in the tangent space at the given mean rotation
+func QuatCovariance(out, mean, quats, weights []float64) []float64 {
+	checkQuatSet(quats, weights)
+	for i := 0; i < 9; i++ {
+		out[i] = 0
+	}
+	inv := QuatConjugate(QuatCreate(), mean)
+	tmp := QuatCreate()
+	v := Vec3Create()
+	wsum := 0.
+	for i := 0; i < len(quats)/4; i++ {
+		w := 1.
+		if weights != nil {
+			w = weights[i]
+		}
+		SO3LogQuat(v, QuatMultiply(tmp, inv, quats[i*4:i*4+4]))
+		for c := 0; c < 3; c++ {
+			for r := 0; r < 3; r++ {
+				out[c*3+r] += w * v[r] * v[c]
+			}
+		}
+		wsum += w
+	}
+	if wsum > 0 {
+		Mat3MultiplyScalar(out, out, 1/wsum)
+	}
+	return out
+}
+
+func checkQuatSet(quats, weights []float64) {
+	if len(quats)%4 != 0 {
+		panic(fmt.Sprintf("Quaternion set must be a multiple of 4 but got %v", len(quats)))
+	}
+	if weights != nil && len(weights) != len(quats)/4 {
+		panic(fmt.Sprintf("Expected %v weights but got %v", len(quats)/4, len(weights)))
+	}
+}
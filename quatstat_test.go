@@ -0,0 +1,89 @@
+package glmatrix
+
+import (
+	"math"
+	"testing"
+)
+
+func TestQuatAverage(t *testing.T) {
+	axis := []float64{0, 0, 1}
+	quats := []float64{}
+	quats = append(quats, QuatSetAxisAngle(QuatCreate(), axis, 0.1)...)
+	quats = append(quats, QuatScale(QuatCreate(), QuatSetAxisAngle(QuatCreate(), axis, 0.3), -1)...)
+	quats = append(quats, QuatSetAxisAngle(QuatCreate(), axis, 0.2)...)
+	actual := QuatAverage(QuatCreate(), quats, nil)
+	expect := QuatSetAxisAngle(QuatCreate(), axis, 0.2)
+	if !testSliceTol(actual, expect, 1e-3) {
+		t.Errorf("average: %v", actual)
+	}
+
+	actual = QuatAverage(QuatCreate(), quats, []float64{1, 0, 0})
+	expect = QuatSetAxisAngle(QuatCreate(), axis, 0.1)
+	if !testSlice(actual, expect) {
+		t.Errorf("weighted average: %v", actual)
+	}
+}
+
+func TestQuatGeodesicMean(t *testing.T) {
+	axis := []float64{0, 1, 0}
+	quats := []float64{}
+	quats = append(quats, QuatSetAxisAngle(QuatCreate(), axis, -0.5)...)
+	quats = append(quats, QuatSetAxisAngle(QuatCreate(), axis, 1)...)
+	quats = append(quats, QuatSetAxisAngle(QuatCreate(), axis, 0.4)...)
+	actual := QuatGeodesicMean(QuatCreate(), quats, nil)
+	expect := QuatSetAxisAngle(QuatCreate(), axis, 0.3)
+	if !testSlice(actual, expect) {
+		t.Errorf("geodesic mean: %v", actual)
+	}
+	actual = QuatGeodesicMean(QuatCreate(), quats, []float64{1, 3, 0})
+	expect = QuatSetAxisAngle(QuatCreate(), axis, 0.625)
+	if !testSlice(actual, expect) {
+		t.Errorf("weighted geodesic mean: %v", actual)
+	}
+}
+
+func TestQuatAngularDistance(t *testing.T) {
+	a := QuatSetAxisAngle(QuatCreate(), []float64{1, 0, 0}, 0.2)
+	b := QuatSetAxisAngle(QuatCreate(), []float64{1, 0, 0}, -0.3)
+	actual := QuatAngularDistance(a, b)
+	if !equals(actual, 0.5) {
+		t.Errorf("angular distance: %v", actual)
+	}
+	actual = QuatAngularDistance(a, QuatScale(b, b, -1))
+	if !equals(actual, 0.5) {
+		t.Errorf("angular distance negated: %v", actual)
+	}
+	b = QuatSetAxisAngle(QuatCreate(), []float64{1, 0, 0}, 0.2+1e-7)
+	actual = QuatAngularDistance(a, b)
+	if math.Abs(actual-1e-7) > 1e-12 {
+		t.Errorf("angular distance small: %v", actual)
+	}
+}
+
+func TestQuatVariance(t *testing.T) {
+	axis := []float64{0, 0, 1}
+	quats := []float64{}
+	quats = append(quats, QuatSetAxisAngle(QuatCreate(), axis, 0.1)...)
+	quats = append(quats, QuatSetAxisAngle(QuatCreate(), axis, -0.1)...)
+	actual := QuatVariance(QuatCreate(), quats, nil)
+	if !equals(actual, 0.01) {
+		t.Errorf("variance: %v", actual)
+	}
+}
+
+func TestQuatCovariance(t *testing.T) {
+	quats := []float64{}
+	quats = append(quats, QuatSetAxisAngle(QuatCreate(), []float64{1, 0, 0}, 0.2)...)
+	quats = append(quats, QuatSetAxisAngle(QuatCreate(), []float64{1, 0, 0}, -0.2)...)
+	quats = append(quats, QuatSetAxisAngle(QuatCreate(), []float64{0, 0, 1}, 0.1)...)
+	quats = append(quats, QuatSetAxisAngle(QuatCreate(), []float64{0, 0, 1}, -0.1)...)
+	actual := QuatCovariance(Mat3Create(), QuatCreate(), quats, nil)
+	expect := []float64{
+		0.02, 0, 0,
+		0, 0, 0,
+		0, 0, 0.005,
+	}
+	if !testSlice(actual, expect) {
+		t.Errorf("covariance: %v", actual)
+	}
+}
@@ -0,0 +1,174 @@
+package glmatrix
+
+import (
+	"fmt"
+	"math"
+)
+
+// Point sets are flat arrays of vec3's in the same layout as Vec3ForEach
+// with a stride of 3. Weights are optional; pass nil to weight every point
+// equally.
+
+// QuatKabsch calculates the rotation and translation that best map the points
+// in src onto the corresponding points in dst in the least squares sense,
+// i.e. dst[i] ~ rotate(out, src[i]) + translation.
+// It returns the root mean square deviation of the aligned points.
+func QuatKabsch(out, translation, src, dst, weights []float64) float64 {
+	_, rmsd := registration(out, translation, src, dst, weights, false)
+	return rmsd
+}
+
+// QuatUmeyama calculates the rotation, translation and uniform scale that best
+// map the points in src onto the corresponding points in dst in the least
+// squares sense, i.e. dst[i] ~ scale * rotate(out, src[i]) + translation.
+// It returns the scale and the root mean square deviation of the aligned points.
+func QuatUmeyama(out, translation, src, dst, weights []float64) (float64, float64) {
+	return registration(out, translation, src, dst, weights, true)
+}
+
+// Mat4Kabsch calculates the rigid transformation matrix that best maps the
+// points in src onto the corresponding points in dst.
+// It returns the root mean square deviation of the aligned points.
+func Mat4Kabsch(out, src, dst, weights []float64) float64 {
+	q := QuatCreate()
+	t := Vec3Create()
+	rmsd := QuatKabsch(q, t, src, dst, weights)
+	Mat4FromRotationTranslation(out, q, t)
+	return rmsd
+}
+
+// Mat4Umeyama calculates the similarity transformation matrix (rotation,
+// translation and uniform scale) that best maps the points in src onto the
+// corresponding points in dst.
+// It returns the root mean square deviation of the aligned points.
+func Mat4Umeyama(out, src, dst, weights []float64) float64 {
+	q := QuatCreate()
+	t := Vec3Create()
+	s, rmsd := QuatUmeyama(q, t, src, dst, weights)
+	Mat4FromRotationTranslationScale(out, q, t, []float64{s, s, s})
+	return rmsd
+}
+
+// Vec3RMSD calculates the root mean square deviation between two sets of points
+func Vec3RMSD(a, b, weights []float64) float64 {
+	checkPointSets(a, b, weights)
+	sum := 0.
+	wsum := 0.
+	for i := 0; i < len(a)/3; i++ {
+		w := 1.
+		if weights != nil {
+			w = weights[i]
+		}
+		sum += w * Vec3SquaredDistance(a[i*3:i*3+3], b[i*3:i*3+3])
+		wsum += w
+	}
+	if wsum <= 0 {
+		return 0
+	}
+	return math.Sqrt(sum / wsum)
+}
+
+func checkPointSets(a, b, weights []float64) {
+	if len(a)%3 != 0 || len(a) != len(b) {
+		panic(fmt.Sprintf("Mismatched point sets of length %v and %v", len(a), len(b)))
+	}
+	if weights != nil && len(weights) != len(a)/3 {
+		panic(fmt.Sprintf("Expected %v weights but got %v", len(a)/3, len(weights)))
+	}
+}
+
+// registration implements Horn's closed-form quaternion method
+func registration(out, translation, src, dst, weights []float64, withScale bool) (float64, float64) {
+	checkPointSets(src, dst, weights)
+	QuatIdentity(out)
+	Vec3Zero(translation)
+
+	n := len(src) / 3
+	wsum := 0.
+	cs := Vec3Create()
+	cd := Vec3Create()
+	for i := 0; i < n; i++ {
+		w := 1.
+		if weights != nil {
+			w = weights[i]
+		}
+		Vec3ScaleAndAdd(cs, cs, src[i*3:i*3+3], w)
+		Vec3ScaleAndAdd(cd, cd, dst[i*3:i*3+3], w)
+		wsum += w
+	}
+	if wsum <= 0 {
+		return 1, 0
+	}
+	Vec3Scale(cs, cs, 1/wsum)
+	Vec3Scale(cd, cd, 1/wsum)
+
+	// cross covariance of the centered points and the spread of the source
+	var sxx, sxy, sxz, syx, syy, syz, szx, szy, szz, spread float64
+	for i := 0; i < n; i++ {
+		w := 1.
+		if weights != nil {
+			w = weights[i]
+		}
+		ax := src[i*3] - cs[0]
+		ay := src[i*3+1] - cs[1]
+		az := src[i*3+2] - cs[2]
+		bx := dst[i*3] - cd[0]
+		by := dst[i*3+1] - cd[1]
+		bz := dst[i*3+2] - cd[2]
+		sxx += w * ax * bx
+		sxy += w * ax * by
+		sxz += w * ax * bz
+		syx += w * ay * bx
+		syy += w * ay * by
+		syz += w * ay * bz
+		szx += w * az * bx
+		szy += w * az * by
+		szz += w * az * bz
+		spread += w * (ax*ax + ay*ay + az*az)
+	}
+
+	// the optimal rotation is the eigenvector of the largest eigenvalue,
+	// ordered as (w, x, y, z)
+	nm := []float64{
+		sxx + syy + szz, syz - szy, szx - sxz, sxy - syx,
+		syz - szy, sxx - syy - szz, sxy + syx, szx + sxz,
+		szx - sxz, sxy + syx, -sxx + syy - szz, syz + szy,
+		sxy - syx, szx + sxz, syz + szy, -sxx - syy + szz,
+	}
+	values, vectors := symmetricEigen(nm, 4)
+	k := 0
+	for i := 1; i < 4; i++ {
+		if values[i] > values[k] {
+			k = i
+		}
+	}
+	out[0] = vectors[k*4+1]
+	out[1] = vectors[k*4+2]
+	out[2] = vectors[k*4+3]
+	out[3] = vectors[k*4]
+	QuatNormalize(out, out)
+	if out[3] < 0 {
+		QuatScale(out, out, -1)
+	}
+
+	scale := 1.
+	if withScale && spread > 0 {
+		scale = values[k] / spread
+	}
+	Vec3TransformQuat(translation, cs, out)
+	Vec3Scale(translation, translation, -scale)
+	Vec3Add(translation, translation, cd)
+
+	sum := 0.
+	p := Vec3Create()
+	for i := 0; i < n; i++ {
+		w := 1.
+		if weights != nil {
+			w = weights[i]
+		}
+		Vec3TransformQuat(p, src[i*3:i*3+3], out)
+		Vec3ScaleAndAdd(p, translation, p, scale)
+		sum += w * Vec3SquaredDistance(p, dst[i*3:i*3+3])
+	}
+	return scale, math.Sqrt(sum / wsum)
+}
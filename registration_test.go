@@ -0,0 +1,122 @@
+package glmatrix
+
+import (
+	"math"
+	"testing"
+)
+
+var registrationSrc = []float64{
+	0, 0, 0,
+	1, 0, 0,
+	0, 2, 0,
+	0, 0, 3,
+	1, 1, 1,
+	-2, 0.5, 1,
+}
+
+func transformPoints(points, q, t []float64, s float64) []float64 {
+	out := make([]float64, len(points))
+	for i := 0; i < len(points); i += 3 {
+		p := Vec3TransformQuat(out[i:i+3], points[i:i+3], q)
+		Vec3ScaleAndAdd(p, t, p, s)
+	}
+	return out
+}
+
+func TestQuatKabsch(t *testing.T) {
+	q := QuatSetAxisAngle(QuatCreate(), Vec3Normalize(Vec3Create(), []float64{1, 2, 3}), 2.5)
+	tr := []float64{4, -5, 6}
+	dst := transformPoints(registrationSrc, q, tr, 1)
+
+	actualQ := QuatCreate()
+	actualT := Vec3Create()
+	rmsd := QuatKabsch(actualQ, actualT, registrationSrc, dst, nil)
+	if !testSlice(actualQ, q) {
+		t.Errorf("kabsch rotation: %v", actualQ)
+	}
+	if !testSlice(actualT, tr) {
+		t.Errorf("kabsch translation: %v", actualT)
+	}
+	if !equals(rmsd, 0) {
+		t.Errorf("kabsch rmsd: %v", rmsd)
+	}
+}
+
+func TestQuatKabschWeighted(t *testing.T) {
+	q := QuatSetAxisAngle(QuatCreate(), []float64{0, 1, 0}, math.Pi/3)
+	tr := []float64{1, 2, 3}
+	src := append([]float64{10, 10, 10}, registrationSrc...)
+	dst := append([]float64{-50, 20, 7}, transformPoints(registrationSrc, q, tr, 1)...)
+	weights := []float64{0, 1, 1, 1, 1, 1, 1}
+
+	actualQ := QuatCreate()
+	actualT := Vec3Create()
+	rmsd := QuatKabsch(actualQ, actualT, src, dst, weights)
+	if !testSlice(actualQ, q) || !testSlice(actualT, tr) {
+		t.Errorf("weighted kabsch: %v %v", actualQ, actualT)
+	}
+	if !equals(rmsd, 0) {
+		t.Errorf("weighted kabsch rmsd: %v", rmsd)
+	}
+	rmsd = QuatKabsch(actualQ, actualT, src, dst, nil)
+	if rmsd < 1 {
+		t.Errorf("unweighted kabsch rmsd: %v", rmsd)
+	}
+}
+
+func TestQuatUmeyama(t *testing.T) {
+	q := QuatSetAxisAngle(QuatCreate(), []float64{0, 0, 1}, -1)
+	tr := []float64{0.5, 0, -2}
+	dst := transformPoints(registrationSrc, q, tr, 2.5)
+
+	actualQ := QuatCreate()
+	actualT := Vec3Create()
+	scale, rmsd := QuatUmeyama(actualQ, actualT, registrationSrc, dst, nil)
+	if !testSlice(actualQ, q) || !testSlice(actualT, tr) {
+		t.Errorf("umeyama: %v %v", actualQ, actualT)
+	}
+	if !equals(scale, 2.5) {
+		t.Errorf("umeyama scale: %v", scale)
+	}
+	if !equals(rmsd, 0) {
+		t.Errorf("umeyama rmsd: %v", rmsd)
+	}
+}
+
+func TestMat4Kabsch(t *testing.T) {
+	q := QuatSetAxisAngle(QuatCreate(), []float64{1, 0, 0}, math.Pi)
+	tr := []float64{1, 1, 1}
+	dst := transformPoints(registrationSrc, q, tr, 1)
+	actual := Mat4Create()
+	Mat4Kabsch(actual, registrationSrc, dst, nil)
+	expect := Mat4FromRotationTranslation(Mat4Create(), q, tr)
+	if !testSlice(actual, expect) {
+		t.Errorf("mat4 kabsch: %v", actual)
+	}
+}
+
+func TestMat4Umeyama(t *testing.T) {
+	q := QuatSetAxisAngle(QuatCreate(), []float64{0, 1, 0}, 0.3)
+	tr := []float64{1, 1, 1}
+	dst := transformPoints(registrationSrc, q, tr, 0.5)
+	actual := Mat4Create()
+	Mat4Umeyama(actual, registrationSrc, dst, nil)
+	expect := Mat4FromRotationTranslationScale(Mat4Create(), q, tr, []float64{0.5, 0.5, 0.5})
+	if !testSlice(actual, expect) {
+		t.Errorf("mat4 umeyama: %v", actual)
+	}
+}
+
+func TestVec3RMSD(t *testing.T) {
+	a := []float64{0, 0, 0, 1, 1, 1}
+	b := []float64{0, 0, 1, 1, 1, 4}
+	actual := Vec3RMSD(a, b, nil)
+	expect := math.Sqrt(5)
+	if !equals(actual, expect) {
+		t.Errorf("rmsd: %v", actual)
+	}
+	actual = Vec3RMSD(a, b, []float64{1, 0})
+	if !equals(actual, 1) {
+		t.Errorf("weighted rmsd: %v", actual)
+	}
+}
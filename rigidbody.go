@@ -0,0 +1,217 @@
+package glmatrix
+
+import "math"
+
+// RigidBody represent the state of a rigid body.
+// Velocities, forces and torques are in world space and the inertia tensor
+// is given in body space about the center of mass.
+type RigidBody struct {
+	Mass            float64
+	Inertia         []float64
+	Position        []float64
+	Orientation     []float64
+	LinearVelocity  []float64
+	AngularVelocity []float64
+	Force           []float64
+	Torque          []float64
+}
+
+// NewRigidBody creates a new rigid body at rest at the origin
+// with the given mass and body space inertia tensor
+func NewRigidBody(mass float64, inertia []float64) *RigidBody {
+	return &RigidBody{
+		Mass:            mass,
+		Inertia:         Mat3Clone(inertia),
+		Position:        Vec3Create(),
+		Orientation:     QuatCreate(),
+		LinearVelocity:  Vec3Create(),
+		AngularVelocity: Vec3Create(),
+		Force:           Vec3Create(),
+		Torque:          Vec3Create(),
+	}
+}
+
+// InverseMass returns the inverse of the mass, or zero for a static body with no mass
+func (b *RigidBody) InverseMass() float64 {
+	if b.Mass <= 0 {
+		return 0
+	}
+	return 1 / b.Mass
+}
+
+// WorldInertia calculates the inertia tensor in world space
+func (b *RigidBody) WorldInertia(out []float64) []float64 {
+	return rigidBodyWorldInertia(out, b.Inertia, b.Orientation)
+}
+
+// WorldInverseInertia calculates the inverse of the inertia tensor in world space.
+// It returns a zero matrix for a static body with no mass.
+func (b *RigidBody) WorldInverseInertia(out []float64) []float64 {
+	inv := Mat3Create()
+	if b.Mass <= 0 || Mat3Invert(inv, b.Inertia) == nil {
+		// set the elements rather than scaling them since Inf * 0 is NaN
+		for i := 0; i < 9; i++ {
+			out[i] = 0
+		}
+		return out
+	}
+	return rigidBodyWorldInertia(out, inv, b.Orientation)
+}
+
+// VelocityAtPoint calculates the velocity of the given world space point moving with the body
+func (b *RigidBody) VelocityAtPoint(out, point []float64) []float64 {
+	r := Vec3Subtract(Vec3Create(), point, b.Position)
+	Vec3Cross(r, b.AngularVelocity, r)
+	return Vec3Add(out, b.LinearVelocity, r)
+}
+
+// ApplyForce accumulates a force acting on the center of mass
+func (b *RigidBody) ApplyForce(force []float64) {
+	Vec3Add(b.Force, b.Force, force)
+}
+
+// ApplyForceAtPoint accumulates a force acting on the given world space point,
+// which also produces a torque about the center of mass
+func (b *RigidBody) ApplyForceAtPoint(force, point []float64) {
+	r := Vec3Subtract(Vec3Create(), point, b.Position)
+	Vec3Add(b.Force, b.Force, force)
+	Vec3Add(b.Torque, b.Torque, Vec3Cross(r, r, force))
+}
+
+// ApplyTorque accumulates a torque
+func (b *RigidBody) ApplyTorque(torque []float64) {
+	Vec3Add(b.Torque, b.Torque, torque)
+}
+
+// ClearForces resets the accumulated force and torque
+func (b *RigidBody) ClearForces() {
+	Vec3Zero(b.Force)
+	Vec3Zero(b.Torque)
+}
+
+// IntegrateEuler advances the body by dt seconds with the semi-implicit Euler
+// method under the accumulated force and torque, which are kept.
+func (b *RigidBody) IntegrateEuler(dt float64) {
+	d := b.derivative(b.state())
+	Vec3ScaleAndAdd(b.LinearVelocity, b.LinearVelocity, d[7:10], dt)
+	Vec3ScaleAndAdd(b.AngularVelocity, b.AngularVelocity, d[10:13], dt)
+	Vec3ScaleAndAdd(b.Position, b.Position, b.LinearVelocity, dt)
+	spin := QuatMultiply(QuatCreate(), []float64{b.AngularVelocity[0], b.AngularVelocity[1], b.AngularVelocity[2], 0}, b.Orientation)
+	QuatScaleAndAdd(b.Orientation, b.Orientation, spin, 0.5*dt)
+	QuatNormalize(b.Orientation, b.Orientation)
+}
+
+// IntegrateRK4 advances the body by dt seconds with the classical Runge-Kutta
+// method under the accumulated force and torque, which are kept.
+func (b *RigidBody) IntegrateRK4(dt float64) {
+	s := b.state()
+	k1 := b.derivative(s)
+	k2 := b.derivative(rigidBodyStep(s, k1, dt/2))
+	k3 := b.derivative(rigidBodyStep(s, k2, dt/2))
+	k4 := b.derivative(rigidBodyStep(s, k3, dt))
+	for i := range s {
+		s[i] += dt / 6 * (k1[i] + 2*k2[i] + 2*k3[i] + k4[i])
+	}
+	Vec3Copy(b.Position, s[0:3])
+	QuatNormalize(b.Orientation, s[3:7])
+	Vec3Copy(b.LinearVelocity, s[7:10])
+	Vec3Copy(b.AngularVelocity, s[10:13])
+}
+
+// state packs position, orientation, linear and angular velocity
+func (b *RigidBody) state() []float64 {
+	s := make([]float64, 0, 13)
+	s = append(s, b.Position...)
+	s = append(s, b.Orientation...)
+	s = append(s, b.LinearVelocity...)
+	return append(s, b.AngularVelocity...)
+}
+
+// derivative calculates the time derivative of a packed state
+func (b *RigidBody) derivative(s []float64) []float64 {
+	d := make([]float64, 13)
+	q := QuatNormalize(QuatCreate(), s[3:7])
+	w := s[10:13]
+	copy(d[0:3], s[7:10])
+	spin := QuatMultiply(QuatCreate(), []float64{w[0], w[1], w[2], 0}, q)
+	QuatScale(d[3:7], spin, 0.5)
+	Vec3Scale(d[7:10], b.Force, b.InverseMass())
+
+	if b.Mass > 0 {
+		inv := Mat3Invert(Mat3Create(), b.Inertia)
+		if inv != nil {
+			// Euler's equation: I dw/dt = torque - w x (I w)
+			iw := Vec3TransformMat3(Vec3Create(), w, rigidBodyWorldInertia(Mat3Create(), b.Inertia, q))
+			Vec3Cross(iw, w, iw)
+			Vec3Subtract(iw, b.Torque, iw)
+			Vec3TransformMat3(d[10:13], iw, rigidBodyWorldInertia(inv, inv, q))
+		}
+	}
+	return d
+}
+
+func rigidBodyStep(s, d []float64, dt float64) []float64 {
+	out := make([]float64, len(s))
+	for i := range s {
+		out[i] = s[i] + d[i]*dt
+	}
+	return out
+}
+
+// rigidBodyWorldInertia calculates R * inertia * R^T
+func rigidBodyWorldInertia(out, inertia, q []float64) []float64 {
+	r := Mat3FromQuat(Mat3Create(), q)
+	rt := Mat3Transpose(Mat3Create(), r)
+	Mat3Multiply(out, r, inertia)
+	return Mat3Multiply(out, out, rt)
+}
+
+// Mat3BoxInertia calculates the inertia tensor of a solid box
+// with the given full extents along each axis
+func Mat3BoxInertia(out []float64, mass float64, size []float64) []float64 {
+	x2 := size[0] * size[0]
+	y2 := size[1] * size[1]
+	z2 := size[2] * size[2]
+	return Mat3Set(out,
+		mass*(y2+z2)/12, 0, 0,
+		0, mass*(x2+z2)/12, 0,
+		0, 0, mass*(x2+y2)/12)
+}
+
+// Mat3SphereInertia calculates the inertia tensor of a solid sphere
+func Mat3SphereInertia(out []float64, mass, radius float64) []float64 {
+	i := 0.4 * mass * radius * radius
+	return Mat3Set(out,
+		i, 0, 0,
+		0, i, 0,
+		0, 0, i)
+}
+
+// Mat3CylinderInertia calculates the inertia tensor of a solid cylinder
+// whose axis is the y axis
+func Mat3CylinderInertia(out []float64, mass, radius, height float64) []float64 {
+	r2 := radius * radius
+	side := mass * (3*r2 + height*height) / 12
+	return Mat3Set(out,
+		side, 0, 0,
+		0, mass*r2/2, 0,
+		0, 0, side)
+}
+
+// Mat3CapsuleInertia calculates the inertia tensor of a solid capsule whose
+// axis is the y axis. The height is the length of the cylindrical part
+// without the hemispherical caps.
+func Mat3CapsuleInertia(out []float64, mass, radius, height float64) []float64 {
+	r2 := radius * radius
+	cylinder := math.Pi * r2 * height
+	caps := 4. / 3 * math.Pi * r2 * radius
+	mc := mass * cylinder / (cylinder + caps)
+	mh := mass - mc
+
+	axial := mc*r2/2 + mh*0.4*r2
+	side := mc*(height*height/12+r2/4) + mh*(0.4*r2+height*height/4+3*height*radius/8)
+	return Mat3Set(out,
+		side, 0, 0,
+		0, axial, 0,
+		0, 0, side)
+}
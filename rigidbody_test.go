@@ -0,0 +1,152 @@
+package glmatrix
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRigidBodyFreeFall(t *testing.T) {
+	b := NewRigidBody(2, Mat3SphereInertia(Mat3Create(), 2, 1))
+	Vec3Set(b.LinearVelocity, 1, 0, 0)
+	b.ApplyForce([]float64{0, -9.8 * 2, 0})
+	for i := 0; i < 100; i++ {
+		b.IntegrateRK4(0.01)
+	}
+	expect := []float64{1, -4.9, 0}
+	if !testSlice(b.Position, expect) {
+		t.Errorf("rk4 free fall: %v", b.Position)
+	}
+
+	b = NewRigidBody(2, Mat3SphereInertia(Mat3Create(), 2, 1))
+	b.ApplyForce([]float64{0, -9.8 * 2, 0})
+	for i := 0; i < 100; i++ {
+		b.IntegrateEuler(0.01)
+	}
+	if !testSlice(b.LinearVelocity, []float64{0, -9.8, 0}) {
+		t.Errorf("euler free fall velocity: %v", b.LinearVelocity)
+	}
+	if math.Abs(b.Position[1]+4.9) > 0.1 {
+		t.Errorf("euler free fall position: %v", b.Position)
+	}
+}
+
+func TestRigidBodySpin(t *testing.T) {
+	for _, integrate := range []string{"euler", "rk4"} {
+		b := NewRigidBody(1, Mat3BoxInertia(Mat3Create(), 1, []float64{1, 2, 3}))
+		Vec3Set(b.AngularVelocity, 0, 0, math.Pi)
+		for i := 0; i < 1000; i++ {
+			if integrate == "euler" {
+				b.IntegrateEuler(0.001)
+			} else {
+				b.IntegrateRK4(0.001)
+			}
+		}
+		expect := QuatSetAxisAngle(QuatCreate(), []float64{0, 0, 1}, math.Pi)
+		if !testSliceTol(b.Orientation, expect, 1e-5) {
+			t.Errorf("%v spin: %v", integrate, b.Orientation)
+		}
+		if !testSlice(b.AngularVelocity, []float64{0, 0, math.Pi}) {
+			t.Errorf("%v spin velocity: %v", integrate, b.AngularVelocity)
+		}
+	}
+}
+
+func TestRigidBodyTorqueFree(t *testing.T) {
+	// angular momentum and kinetic energy are conserved without torque
+	b := NewRigidBody(1, Mat3BoxInertia(Mat3Create(), 1, []float64{1, 2, 3}))
+	Vec3Set(b.AngularVelocity, 1, 0.1, 0.2)
+	momentum := func() []float64 {
+		return Vec3TransformMat3(Vec3Create(), b.AngularVelocity, b.WorldInertia(Mat3Create()))
+	}
+	l0 := momentum()
+	e0 := Vec3Dot(b.AngularVelocity, l0)
+	for i := 0; i < 1000; i++ {
+		b.IntegrateRK4(0.01)
+	}
+	l := momentum()
+	if !testSliceTol(l, l0, 1e-6) {
+		t.Errorf("angular momentum: %v %v", l, l0)
+	}
+	if e := Vec3Dot(b.AngularVelocity, l); math.Abs(e-e0) > 1e-6 {
+		t.Errorf("kinetic energy: %v %v", e, e0)
+	}
+}
+
+func TestRigidBodyApplyForceAtPoint(t *testing.T) {
+	b := NewRigidBody(1, Mat3SphereInertia(Mat3Create(), 1, 1))
+	Vec3Set(b.Position, 1, 1, 1)
+	b.ApplyForceAtPoint([]float64{0, 2, 0}, []float64{2, 1, 1})
+	if !testSlice(b.Force, []float64{0, 2, 0}) {
+		t.Errorf("force: %v", b.Force)
+	}
+	if !testSlice(b.Torque, []float64{0, 0, 2}) {
+		t.Errorf("torque: %v", b.Torque)
+	}
+	b.ApplyTorque([]float64{1, 0, 0})
+	if !testSlice(b.Torque, []float64{1, 0, 2}) {
+		t.Errorf("apply torque: %v", b.Torque)
+	}
+	b.ClearForces()
+	if !testSlice(b.Force, []float64{0, 0, 0}) || !testSlice(b.Torque, []float64{0, 0, 0}) {
+		t.Errorf("clear forces: %v %v", b.Force, b.Torque)
+	}
+}
+
+func TestRigidBodyVelocityAtPoint(t *testing.T) {
+	b := NewRigidBody(1, Mat3SphereInertia(Mat3Create(), 1, 1))
+	Vec3Set(b.LinearVelocity, 1, 0, 0)
+	Vec3Set(b.AngularVelocity, 0, 0, 2)
+	actual := b.VelocityAtPoint(Vec3Create(), []float64{1, 0, 0})
+	expect := []float64{1, 2, 0}
+	if !testSlice(actual, expect) {
+		t.Errorf("velocity at point: %v", actual)
+	}
+}
+
+func TestRigidBodyWorldInertia(t *testing.T) {
+	b := NewRigidBody(1, Mat3BoxInertia(Mat3Create(), 12, []float64{1, 2, 3}))
+	QuatSetAxisAngle(b.Orientation, []float64{0, 0, 1}, math.Pi/2)
+	actual := b.WorldInertia(Mat3Create())
+	expect := []float64{
+		10, 0, 0,
+		0, 13, 0,
+		0, 0, 5,
+	}
+	if !testSlice(actual, expect) {
+		t.Errorf("world inertia: %v", actual)
+	}
+	actual = Mat3Multiply(actual, actual, b.WorldInverseInertia(Mat3Create()))
+	if !testSlice(actual, Mat3Create()) {
+		t.Errorf("world inverse inertia: %v", actual)
+	}
+	static := NewRigidBody(0, Mat3Create())
+	if !testSlice(static.WorldInverseInertia(Mat3Create()), make([]float64, 9)) {
+		t.Errorf("static world inverse inertia")
+	}
+	out := []float64{math.NaN(), math.Inf(1), math.Inf(-1), 1, 1, 1, 1, 1, 1}
+	if actual := static.WorldInverseInertia(out); !testSlice(actual, make([]float64, 9)) {
+		t.Errorf("static world inverse inertia over non-finite values: %v", actual)
+	}
+}
+
+func TestMat3Inertia(t *testing.T) {
+	actual := Mat3SphereInertia(Mat3Create(), 5, 2)
+	if !testSlice(actual, []float64{8, 0, 0, 0, 8, 0, 0, 0, 8}) {
+		t.Errorf("sphere inertia: %v", actual)
+	}
+	actual = Mat3CylinderInertia(Mat3Create(), 12, 1, 2)
+	if !testSlice(actual, []float64{7, 0, 0, 0, 6, 0, 0, 0, 7}) {
+		t.Errorf("cylinder inertia: %v", actual)
+	}
+	// a capsule without a cylindrical part is a sphere
+	actual = Mat3CapsuleInertia(Mat3Create(), 5, 2, 0)
+	if !testSlice(actual, Mat3SphereInertia(Mat3Create(), 5, 2)) {
+		t.Errorf("capsule inertia: %v", actual)
+	}
+	// a thin capsule approaches a cylinder
+	actual = Mat3CapsuleInertia(Mat3Create(), 12, 1e-4, 2)
+	expect := Mat3CylinderInertia(Mat3Create(), 12, 1e-4, 2)
+	if !testSliceTol(actual, expect, 1e-3) {
+		t.Errorf("thin capsule inertia: %v", actual)
+	}
+}
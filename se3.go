@@ -0,0 +1,200 @@
+package glmatrix
+
+import "math"
+
+// SE(3) twists are 6-vectors [vx, vy, vz, wx, wy, wz] whose first half is
+// the translational part and whose second half is the rotation vector.
+// 6x6 matrices such as adjoints and jacobians are stored in column-major
+// order like every other matrix in this package.
+
+// SE3Hat returns the mat4 of a twist
+func SE3Hat(out, xi []float64) []float64 {
+	out[0] = 0
+	out[1] = xi[5]
+	out[2] = -xi[4]
+	out[3] = 0
+	out[4] = -xi[5]
+	out[5] = 0
+	out[6] = xi[3]
+	out[7] = 0
+	out[8] = xi[4]
+	out[9] = -xi[3]
+	out[10] = 0
+	out[11] = 0
+	out[12] = xi[0]
+	out[13] = xi[1]
+	out[14] = xi[2]
+	out[15] = 0
+	return out
+}
+
+// SE3Vee extracts the twist from a mat4 created by SE3Hat
+func SE3Vee(out, m []float64) []float64 {
+	out[0] = m[12]
+	out[1] = m[13]
+	out[2] = m[14]
+	out[3] = (m[6] - m[9]) * 0.5
+	out[4] = (m[8] - m[2]) * 0.5
+	out[5] = (m[1] - m[4]) * 0.5
+	return out
+}
+
+// SE3Exp creates a rigid transformation mat4 from a twist
+func SE3Exp(out, xi []float64) []float64 {
+	w := xi[3:6]
+	r := SO3Exp(Mat3Create(), w)
+	t := Vec3TransformMat3(Vec3Create(), xi[0:3], SO3LeftJacobian(Mat3Create(), w))
+	return se3FromRotationTranslation(out, r, t)
+}
+
+// SE3Log calculates the twist of a rigid transformation mat4
+func SE3Log(out, m []float64) []float64 {
+	w := SO3Log(Vec3Create(), Mat3FromMat4(Mat3Create(), m))
+	jinv := SO3LeftJacobianInverse(Mat3Create(), w)
+	v := Vec3TransformMat3(Vec3Create(), []float64{m[12], m[13], m[14]}, jinv)
+	out[0] = v[0]
+	out[1] = v[1]
+	out[2] = v[2]
+	out[3] = w[0]
+	out[4] = w[1]
+	out[5] = w[2]
+	return out
+}
+
+// SE3ExpQuat2 creates a dual quat from a twist
+func SE3ExpQuat2(out, xi []float64) []float64 {
+	w := xi[3:6]
+	q := SO3ExpQuat(QuatCreate(), w)
+	t := Vec3TransformMat3(Vec3Create(), xi[0:3], SO3LeftJacobian(Mat3Create(), w))
+	return Quat2FromRotationTranslation(out, q, t)
+}
+
+// SE3LogQuat2 calculates the twist of a normalized dual quat
+func SE3LogQuat2(out, a []float64) []float64 {
+	w := SO3LogQuat(Vec3Create(), a[0:4])
+	t := Quat2GetTranslation(Vec3Create(), a)
+	v := Vec3TransformMat3(Vec3Create(), t, SO3LeftJacobianInverse(Mat3Create(), w))
+	out[0] = v[0]
+	out[1] = v[1]
+	out[2] = v[2]
+	out[3] = w[0]
+	out[4] = w[1]
+	out[5] = w[2]
+	return out
+}
+
+// SE3Adjoint calculates the 6x6 adjoint matrix of a rigid transformation mat4.
+// For a transformation T and a twist xi, SE3Adjoint(T) * xi equals
+// SE3Vee(T * SE3Hat(xi) * T^-1).
+func SE3Adjoint(out, m []float64) []float64 {
+	r := Mat3FromMat4(Mat3Create(), m)
+	tr := Mat3Multiply(Mat3Create(), SO3Hat(Mat3Create(), []float64{m[12], m[13], m[14]}), r)
+	return se3Block(out, r, tr, r)
+}
+
+// SE3LeftJacobian calculates the 6x6 left jacobian of SE(3) at the given twist
+func SE3LeftJacobian(out, xi []float64) []float64 {
+	w := xi[3:6]
+	j := SO3LeftJacobian(Mat3Create(), w)
+	return se3Block(out, j, se3Q(xi[0:3], w), j)
+}
+
+// SE3LeftJacobianInverse calculates the inverse of the 6x6 left jacobian of SE(3)
+// at the given twist
+func SE3LeftJacobianInverse(out, xi []float64) []float64 {
+	w := xi[3:6]
+	jinv := SO3LeftJacobianInverse(Mat3Create(), w)
+	q := se3Q(xi[0:3], w)
+	Mat3Multiply(q, jinv, q)
+	Mat3Multiply(q, q, jinv)
+	Mat3MultiplyScalar(q, q, -1)
+	return se3Block(out, jinv, q, jinv)
+}
+
+// SE3RightJacobian calculates the 6x6 right jacobian of SE(3) at the given twist
+func SE3RightJacobian(out, xi []float64) []float64 {
+	return SE3LeftJacobian(out, se3Negate(xi))
+}
+
+// SE3RightJacobianInverse calculates the inverse of the 6x6 right jacobian of SE(3)
+// at the given twist
+func SE3RightJacobianInverse(out, xi []float64) []float64 {
+	return SE3LeftJacobianInverse(out, se3Negate(xi))
+}
+
+func se3Negate(xi []float64) []float64 {
+	return []float64{-xi[0], -xi[1], -xi[2], -xi[3], -xi[4], -xi[5]}
+}
+
+func se3FromRotationTranslation(out, r, t []float64) []float64 {
+	out[0] = r[0]
+	out[1] = r[1]
+	out[2] = r[2]
+	out[3] = 0
+	out[4] = r[3]
+	out[5] = r[4]
+	out[6] = r[5]
+	out[7] = 0
+	out[8] = r[6]
+	out[9] = r[7]
+	out[10] = r[8]
+	out[11] = 0
+	out[12] = t[0]
+	out[13] = t[1]
+	out[14] = t[2]
+	out[15] = 1
+	return out
+}
+
+// se3Block assembles the 6x6 matrix [[a, b], [0, c]] from three mat3's
+func se3Block(out, a, b, c []float64) []float64 {
+	for col := 0; col < 3; col++ {
+		for row := 0; row < 3; row++ {
+			out[col*6+row] = a[col*3+row]
+			out[col*6+row+3] = 0
+			out[(col+3)*6+row] = b[col*3+row]
+			out[(col+3)*6+row+3] = c[col*3+row]
+		}
+	}
+	return out
+}
+
+// se3Q calculates the upper-right block of the SE(3) left jacobian
+func se3Q(v, w []float64) []float64 {
+	theta2 := Vec3SquaredLength(w)
+	theta := math.Sqrt(theta2)
+	var a, b, c float64
+	if theta < 1e-3 {
+		a = 1./6 - theta2/120
+		b = 1./24 - theta2/720
+		c = 1./120 - theta2/2520
+	} else {
+		theta4 := theta2 * theta2
+		s := math.Sin(theta)
+		co := math.Cos(theta)
+		a = (theta - s) / (theta2 * theta)
+		b = (theta2 + 2*co - 2) / (2 * theta4)
+		c = (2*theta - 3*s + theta*co) / (2 * theta4 * theta)
+	}
+
+	vx := SO3Hat(Mat3Create(), v)
+	wx := SO3Hat(Mat3Create(), w)
+	wv := Mat3Multiply(Mat3Create(), wx, vx)
+	vw := Mat3Multiply(Mat3Create(), vx, wx)
+	wvw := Mat3Multiply(Mat3Create(), wv, wx)
+	wwv := Mat3Multiply(Mat3Create(), wx, wv)
+	vww := Mat3Multiply(Mat3Create(), vw, wx)
+	wvww := Mat3Multiply(Mat3Create(), wvw, wx)
+	wwvw := Mat3Multiply(Mat3Create(), wx, wvw)
+
+	out := Mat3MultiplyScalar(Mat3Create(), vx, 0.5)
+	tmp := Mat3Add(Mat3Create(), wv, vw)
+	Mat3Add(tmp, tmp, wvw)
+	Mat3MultiplyScalarAndAdd(out, out, tmp, a)
+	Mat3Add(tmp, wwv, vww)
+	Mat3MultiplyScalarAndAdd(tmp, tmp, wvw, -3)
+	Mat3MultiplyScalarAndAdd(out, out, tmp, b)
+	Mat3Add(tmp, wvww, wwvw)
+	Mat3MultiplyScalarAndAdd(out, out, tmp, c)
+	return out
+}
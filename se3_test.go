@@ -0,0 +1,120 @@
+package glmatrix
+
+import (
+	"testing"
+)
+
+var se3Xi = []float64{1, -2, 0.5, 0.3, -0.5, 0.8}
+
+func TestSE3HatVee(t *testing.T) {
+	hat := SE3Hat(Mat4Create(), se3Xi)
+	actual := Vec4TransformMat4(Vec4Create(), []float64{1, 2, 3, 1}, hat)
+	w := Vec3Cross(Vec3Create(), se3Xi[3:6], []float64{1, 2, 3})
+	expect := []float64{w[0] + se3Xi[0], w[1] + se3Xi[1], w[2] + se3Xi[2], 0}
+	if !testSlice(actual, expect) {
+		t.Errorf("hat: %v", actual)
+	}
+	actual = SE3Vee(make([]float64, 6), hat)
+	if !testSlice(actual, se3Xi) {
+		t.Errorf("vee: %v", actual)
+	}
+}
+
+func TestSE3Exp(t *testing.T) {
+	actual := SE3Exp(Mat4Create(), []float64{1, 2, 3, 0, 0, 0})
+	expect := Mat4FromTranslation(Mat4Create(), []float64{1, 2, 3})
+	if !testSlice(actual, expect) {
+		t.Errorf("exp translation: %v", actual)
+	}
+	actual = SE3Log(make([]float64, 6), SE3Exp(Mat4Create(), se3Xi))
+	if !testSlice(actual, se3Xi) {
+		t.Errorf("log exp: %v", actual)
+	}
+}
+
+func TestSE3ExpQuat2(t *testing.T) {
+	dq := SE3ExpQuat2(Quat2Create(), se3Xi)
+	actual := Mat4FromQuat2(Mat4Create(), dq)
+	expect := SE3Exp(Mat4Create(), se3Xi)
+	if !testSlice(actual, expect) {
+		t.Errorf("exp quat2: %v", actual)
+	}
+	actual = SE3LogQuat2(make([]float64, 6), dq)
+	if !testSlice(actual, se3Xi) {
+		t.Errorf("log quat2: %v", actual)
+	}
+}
+
+func TestSE3Adjoint(t *testing.T) {
+	m := SE3Exp(Mat4Create(), se3Xi)
+	ad := SE3Adjoint(make([]float64, 36), m)
+	xi := []float64{0.2, 0.1, -0.4, 0.7, 0.1, 0.3}
+	actual := mat6TransformVec6(ad, xi)
+
+	tmp := Mat4Multiply(Mat4Create(), m, SE3Hat(Mat4Create(), xi))
+	Mat4Multiply(tmp, tmp, Mat4Invert(Mat4Create(), m))
+	expect := SE3Vee(make([]float64, 6), tmp)
+	if !testSlice(actual, expect) {
+		t.Errorf("adjoint: %v %v", actual, expect)
+	}
+}
+
+func TestSE3LeftJacobian(t *testing.T) {
+	// exp(xi + d) ~ exp(J d) exp(xi)
+	h := 1e-6
+	j := SE3LeftJacobian(make([]float64, 36), se3Xi)
+	minv := Mat4Invert(Mat4Create(), SE3Exp(Mat4Create(), se3Xi))
+	for i := 0; i < 6; i++ {
+		xi := append([]float64{}, se3Xi...)
+		xi[i] += h
+		md := SE3Exp(Mat4Create(), xi)
+		actual := SE3Log(make([]float64, 6), Mat4Multiply(md, md, minv))
+		for k := range actual {
+			actual[k] /= h
+		}
+		expect := j[i*6 : i*6+6]
+		if !testSliceTol(actual, expect, 1e-5) {
+			t.Errorf("left jacobian column %v: %v %v", i, actual, expect)
+		}
+	}
+}
+
+func TestSE3LeftJacobianInverse(t *testing.T) {
+	for _, xi := range [][]float64{se3Xi, {1, 2, 3, 1e-5, 0, 2e-5}} {
+		j := SE3LeftJacobian(make([]float64, 36), xi)
+		jinv := SE3LeftJacobianInverse(make([]float64, 36), xi)
+		v := []float64{1, 2, 3, 4, 5, 6}
+		actual := mat6TransformVec6(jinv, mat6TransformVec6(j, v))
+		if !testSlice(actual, v) {
+			t.Errorf("left jacobian inverse: %v", actual)
+		}
+	}
+}
+
+func TestSE3RightJacobian(t *testing.T) {
+	// J_r(xi) = Ad(exp(-xi)) J_l(xi)
+	ad := SE3Adjoint(make([]float64, 36), SE3Exp(Mat4Create(), se3Negate(se3Xi)))
+	jl := SE3LeftJacobian(make([]float64, 36), se3Xi)
+	jr := SE3RightJacobian(make([]float64, 36), se3Xi)
+	jrinv := SE3RightJacobianInverse(make([]float64, 36), se3Xi)
+	v := []float64{1, 2, 3, 4, 5, 6}
+	actual := mat6TransformVec6(jr, v)
+	expect := mat6TransformVec6(ad, mat6TransformVec6(jl, v))
+	if !testSlice(actual, expect) {
+		t.Errorf("right jacobian: %v %v", actual, expect)
+	}
+	actual = mat6TransformVec6(jrinv, actual)
+	if !testSlice(actual, v) {
+		t.Errorf("right jacobian inverse: %v", actual)
+	}
+}
+
+func mat6TransformVec6(m, v []float64) []float64 {
+	out := make([]float64, 6)
+	for col := 0; col < 6; col++ {
+		for row := 0; row < 6; row++ {
+			out[row] += m[col*6+row] * v[col]
+		}
+	}
+	return out
+}
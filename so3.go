@@ -0,0 +1,142 @@
+package glmatrix
+
+import "math"
+
+// SO3Hat returns the skew-symmetric mat3 of a vec3,
+// so that SO3Hat(m, v) * u equals Vec3Cross(v, u)
+func SO3Hat(out, v []float64) []float64 {
+	out[0] = 0
+	out[1] = v[2]
+	out[2] = -v[1]
+	out[3] = -v[2]
+	out[4] = 0
+	out[5] = v[0]
+	out[6] = v[1]
+	out[7] = -v[0]
+	out[8] = 0
+	return out
+}
+
+// SO3Vee extracts the vec3 from a skew-symmetric mat3.
+// Only the skew-symmetric part of the matrix is taken into account.
+func SO3Vee(out, m []float64) []float64 {
+	x := (m[5] - m[7]) * 0.5
+	y := (m[6] - m[2]) * 0.5
+	z := (m[1] - m[3]) * 0.5
+	out[0] = x
+	out[1] = y
+	out[2] = z
+	return out
+}
+
+// SO3ExpQuat creates a unit quat from a rotation vector
+// whose direction is the rotation axis and length is the angle in radians
+func SO3ExpQuat(out, v []float64) []float64 {
+	out[0] = v[0] * 0.5
+	out[1] = v[1] * 0.5
+	out[2] = v[2] * 0.5
+	out[3] = 0
+	return QuatExp(out, out)
+}
+
+// SO3LogQuat calculates the rotation vector of a unit quat.
+// The result always represents the shortest rotation, i.e. its length is in [0, pi].
+func SO3LogQuat(out, q []float64) []float64 {
+	tmp := []float64{q[0], q[1], q[2], q[3]}
+	if tmp[3] < 0 {
+		QuatScale(tmp, tmp, -1)
+	}
+	QuatLn(tmp, tmp)
+	out[0] = tmp[0] * 2
+	out[1] = tmp[1] * 2
+	out[2] = tmp[2] * 2
+	return out
+}
+
+// SO3Exp creates a rotation mat3 from a rotation vector using the Rodrigues formula
+func SO3Exp(out, v []float64) []float64 {
+	theta2 := Vec3SquaredLength(v)
+	theta := math.Sqrt(theta2)
+	var a, b float64
+	if theta < 1e-4 {
+		a = 1 - theta2/6
+		b = 0.5 - theta2/24
+	} else {
+		a = math.Sin(theta) / theta
+		b = (1 - math.Cos(theta)) / theta2
+	}
+	return so3Compose(out, v, 1, a, b)
+}
+
+// SO3Log calculates the rotation vector of a rotation mat3
+func SO3Log(out, m []float64) []float64 {
+	q := QuatFromMat3(QuatCreate(), m)
+	QuatNormalize(q, q)
+	return SO3LogQuat(out, q)
+}
+
+// SO3LeftJacobian calculates the left jacobian of SO(3) at the given rotation vector.
+// It maps a perturbation of the rotation vector to the corresponding
+// perturbation applied on the left side of the rotation.
+func SO3LeftJacobian(out, v []float64) []float64 {
+	theta2 := Vec3SquaredLength(v)
+	theta := math.Sqrt(theta2)
+	var a, b float64
+	if theta < 1e-4 {
+		a = 0.5 - theta2/24
+		b = 1./6 - theta2/120
+	} else {
+		a = (1 - math.Cos(theta)) / theta2
+		b = (theta - math.Sin(theta)) / (theta2 * theta)
+	}
+	return so3Compose(out, v, 1, a, b)
+}
+
+// SO3LeftJacobianInverse calculates the inverse of the left jacobian of SO(3)
+// at the given rotation vector
+func SO3LeftJacobianInverse(out, v []float64) []float64 {
+	theta2 := Vec3SquaredLength(v)
+	theta := math.Sqrt(theta2)
+	var b float64
+	if theta < 1e-4 {
+		b = 1./12 + theta2/720
+	} else {
+		b = 1/theta2 - (1+math.Cos(theta))/(2*theta*math.Sin(theta))
+	}
+	return so3Compose(out, v, 1, -0.5, b)
+}
+
+// SO3RightJacobian calculates the right jacobian of SO(3) at the given rotation vector
+func SO3RightJacobian(out, v []float64) []float64 {
+	return SO3LeftJacobian(out, Vec3Negate(Vec3Create(), v))
+}
+
+// SO3RightJacobianInverse calculates the inverse of the right jacobian of SO(3)
+// at the given rotation vector
+func SO3RightJacobianInverse(out, v []float64) []float64 {
+	return SO3LeftJacobianInverse(out, Vec3Negate(Vec3Create(), v))
+}
+
+// so3Compose sets out to i*I + a*K + b*K^2 where K is the skew-symmetric matrix of v
+func so3Compose(out, v []float64, i, a, b float64) []float64 {
+	x := v[0]
+	y := v[1]
+	z := v[2]
+	xx := x * x
+	yy := y * y
+	zz := z * z
+	xy := x * y
+	xz := x * z
+	yz := y * z
+
+	out[0] = i - b*(yy+zz)
+	out[1] = a*z + b*xy
+	out[2] = -a*y + b*xz
+	out[3] = -a*z + b*xy
+	out[4] = i - b*(xx+zz)
+	out[5] = a*x + b*yz
+	out[6] = a*y + b*xz
+	out[7] = -a*x + b*yz
+	out[8] = i - b*(xx+yy)
+	return out
+}
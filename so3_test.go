@@ -0,0 +1,110 @@
+package glmatrix
+
+import (
+	"math"
+	"testing"
+)
+
+var so3V = []float64{0.3, -0.5, 0.8}
+
+func TestSO3HatVee(t *testing.T) {
+	hat := SO3Hat(Mat3Create(), so3V)
+	actual := Vec3TransformMat3(Vec3Create(), []float64{1, 2, 3}, hat)
+	expect := Vec3Cross(Vec3Create(), so3V, []float64{1, 2, 3})
+	if !testSlice(actual, expect) {
+		t.Errorf("hat: %v", actual)
+	}
+	actual = SO3Vee(Vec3Create(), hat)
+	if !testSlice(actual, so3V) {
+		t.Errorf("vee: %v", actual)
+	}
+}
+
+func TestSO3ExpQuat(t *testing.T) {
+	actual := SO3ExpQuat(QuatCreate(), []float64{0, 0, math.Pi / 2})
+	expect := QuatSetAxisAngle(QuatCreate(), []float64{0, 0, 1}, math.Pi/2)
+	if !testSlice(actual, expect) {
+		t.Errorf("exp quat: %v", actual)
+	}
+}
+
+func TestSO3LogQuat(t *testing.T) {
+	q := SO3ExpQuat(QuatCreate(), so3V)
+	actual := SO3LogQuat(Vec3Create(), q)
+	if !testSlice(actual, so3V) {
+		t.Errorf("log quat: %v", actual)
+	}
+	actual = SO3LogQuat(Vec3Create(), QuatScale(q, q, -1))
+	if !testSlice(actual, so3V) {
+		t.Errorf("log quat negated: %v", actual)
+	}
+}
+
+func TestSO3Exp(t *testing.T) {
+	actual := SO3Exp(Mat3Create(), so3V)
+	expect := Mat3FromQuat(Mat3Create(), SO3ExpQuat(QuatCreate(), so3V))
+	if !testSlice(actual, expect) {
+		t.Errorf("exp: %v", actual)
+	}
+	actual = SO3Exp(Mat3Create(), []float64{0, 0, 0})
+	if !testSlice(actual, Mat3Create()) {
+		t.Errorf("exp zero: %v", actual)
+	}
+}
+
+func TestSO3Log(t *testing.T) {
+	actual := SO3Log(Vec3Create(), SO3Exp(Mat3Create(), so3V))
+	if !testSlice(actual, so3V) {
+		t.Errorf("log: %v", actual)
+	}
+	v := []float64{0, math.Pi, 0}
+	actual = SO3Log(Vec3Create(), SO3Exp(Mat3Create(), v))
+	if !testSlice(actual, v) {
+		t.Errorf("log half turn: %v", actual)
+	}
+}
+
+func TestSO3LeftJacobian(t *testing.T) {
+	// exp(v + d) ~ exp(J d) exp(v)
+	h := 1e-6
+	j := SO3LeftJacobian(Mat3Create(), so3V)
+	r := SO3Exp(Mat3Create(), so3V)
+	rinv := Mat3Transpose(Mat3Create(), r)
+	for i := 0; i < 3; i++ {
+		d := []float64{0, 0, 0}
+		d[i] = h
+		rd := SO3Exp(Mat3Create(), Vec3Add(Vec3Create(), so3V, d))
+		actual := SO3Log(Vec3Create(), Mat3Multiply(rd, rd, rinv))
+		Vec3Scale(actual, actual, 1/h)
+		expect := j[i*3 : i*3+3]
+		if !testSliceTol(actual, expect, 1e-5) {
+			t.Errorf("left jacobian column %v: %v %v", i, actual, expect)
+		}
+	}
+}
+
+func TestSO3LeftJacobianInverse(t *testing.T) {
+	for _, v := range [][]float64{so3V, {1e-5, 0, 2e-5}} {
+		j := SO3LeftJacobian(Mat3Create(), v)
+		jinv := SO3LeftJacobianInverse(Mat3Create(), v)
+		actual := Mat3Multiply(Mat3Create(), j, jinv)
+		if !testSlice(actual, Mat3Create()) {
+			t.Errorf("left jacobian inverse: %v", actual)
+		}
+	}
+}
+
+func TestSO3RightJacobian(t *testing.T) {
+	// J_r(v) = R^T J_l(v)
+	r := SO3Exp(Mat3Create(), so3V)
+	expect := Mat3Multiply(Mat3Create(), Mat3Transpose(Mat3Create(), r), SO3LeftJacobian(Mat3Create(), so3V))
+	actual := SO3RightJacobian(Mat3Create(), so3V)
+	if !testSlice(actual, expect) {
+		t.Errorf("right jacobian: %v", actual)
+	}
+	jinv := SO3RightJacobianInverse(Mat3Create(), so3V)
+	actual = Mat3Multiply(Mat3Create(), actual, jinv)
+	if !testSlice(actual, Mat3Create()) {
+		t.Errorf("right jacobian inverse: %v", actual)
+	}
+}
@@ -0,0 +1,165 @@
+package glmatrix
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// SpatialHash is a set of vec3 points bucketed into a grid of cubic cells,
+// which finds the points near a position without comparing it to all of them
+type SpatialHash struct {
+	cellSize float64
+	cells    map[[3]int64][]int
+	points   []float64
+}
+
+// NewSpatialHash creates an empty spatial hash whose cells have the given
+// size. Queries are fastest when the size is close to their radius.
+func NewSpatialHash(cellSize float64) *SpatialHash {
+	if !(cellSize > 0) || math.IsInf(cellSize, 1) {
+		panic(fmt.Sprintf("Invalid cell size %v", cellSize))
+	}
+	return &SpatialHash{cellSize: cellSize, cells: map[[3]int64][]int{}}
+}
+
+// Len returns the number of points in a spatial hash
+func (h *SpatialHash) Len() int {
+	return len(h.points) / 3
+}
+
+// Insert adds a point to a spatial hash and returns its index
+func (h *SpatialHash) Insert(p []float64) int {
+	i := h.Len()
+	h.points = append(h.points, p[0], p[1], p[2])
+	key, _ := h.cell(p, 0)
+	h.cells[key] = append(h.cells[key], i)
+	return i
+}
+
+// Point copies the ith point of a spatial hash to out
+func (h *SpatialHash) Point(out []float64, i int) []float64 {
+	return Vec3Copy(out, h.points[i*3:i*3+3])
+}
+
+// Nearest returns the index of the point closest to p whose distance is at
+// most radius, or -1 if there is none. Of equally close points the one
+// inserted first is returned.
+func (h *SpatialHash) Nearest(p []float64, radius float64) int {
+	nearest := -1
+	min := math.Inf(1)
+	h.visit(p, radius, func(i int, d float64) {
+		if d < min || (d == min && i < nearest) {
+			nearest, min = i, d
+		}
+	})
+	return nearest
+}
+
+// Within returns the indices of the points whose distance to p is at most
+// radius, sorted by the distance and then by the index
+func (h *SpatialHash) Within(p []float64, radius float64) []int {
+	var indices []int
+	var distances []float64
+	h.visit(p, radius, func(i int, d float64) {
+		indices = append(indices, i)
+		distances = append(distances, d)
+	})
+	sort.Sort(spatialHits{indices, distances})
+	return indices
+}
+
+// spatialHashLimit bounds the coordinates of the cells so that they and the
+// number of cells in a range fit in an int64
+const spatialHashLimit = 1 << 60
+
+// cell returns the key of the cell containing p moved by offset on each axis.
+// The coordinates are clamped to spatialHashLimit and ok is false if any of
+// them was clamped.
+func (h *SpatialHash) cell(p []float64, offset float64) (key [3]int64, ok bool) {
+	ok = true
+	for k := 0; k < 3; k++ {
+		c := math.Floor((p[k] + offset) / h.cellSize)
+		if !(math.Abs(c) < spatialHashLimit) {
+			ok = false
+			switch {
+			case c > 0:
+				c = spatialHashLimit
+			case c < 0:
+				c = -spatialHashLimit
+			default:
+				c = 0
+			}
+		}
+		key[k] = int64(c)
+	}
+	return key, ok
+}
+
+// visit calls fn with the index and the distance of each point within radius
+// of p
+func (h *SpatialHash) visit(p []float64, radius float64, fn func(int, float64)) {
+	if !(radius >= 0) {
+		return
+	}
+	check := func(i int) {
+		if d := Vec3Distance(p, h.points[i*3:i*3+3]); d <= radius {
+			fn(i, d)
+		}
+	}
+	lo, loOk := h.cell(p, -radius)
+	hi, hiOk := h.cell(p, radius)
+	if !loOk || !hiOk {
+		// the range of cells is unbounded, e.g. for an infinite radius
+		for i := 0; i < h.Len(); i++ {
+			check(i)
+		}
+		return
+	}
+	cells := 1.
+	for k := 0; k < 3; k++ {
+		cells *= float64(hi[k]-lo[k]) + 1
+	}
+	if cells > float64(len(h.cells)) {
+		// scanning the occupied cells is cheaper than looking up the empty ones
+		for key, indices := range h.cells {
+			if lo[0] <= key[0] && key[0] <= hi[0] && lo[1] <= key[1] && key[1] <= hi[1] && lo[2] <= key[2] && key[2] <= hi[2] {
+				for _, i := range indices {
+					check(i)
+				}
+			}
+		}
+		return
+	}
+	for x := lo[0]; x <= hi[0]; x++ {
+		for y := lo[1]; y <= hi[1]; y++ {
+			for z := lo[2]; z <= hi[2]; z++ {
+				for _, i := range h.cells[[3]int64{x, y, z}] {
+					check(i)
+				}
+			}
+		}
+	}
+}
+
+// spatialHits sorts the results of a query by distance and index
+type spatialHits struct {
+	indices   []int
+	distances []float64
+}
+
+func (s spatialHits) Len() int {
+	return len(s.indices)
+}
+
+func (s spatialHits) Less(i, j int) bool {
+	if s.distances[i] != s.distances[j] {
+		return s.distances[i] < s.distances[j]
+	}
+	return s.indices[i] < s.indices[j]
+}
+
+func (s spatialHits) Swap(i, j int) {
+	s.indices[i], s.indices[j] = s.indices[j], s.indices[i]
+	s.distances[i], s.distances[j] = s.distances[j], s.distances[i]
+}
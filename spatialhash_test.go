@@ -0,0 +1,109 @@
+package glmatrix
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestSpatialHashNearest(t *testing.T) {
+	h := NewSpatialHash(1)
+	h.Insert([]float64{0.99, 0, 0})
+	h.Insert([]float64{1.02, 0, 0})
+	h.Insert([]float64{-0.5, -0.5, -0.5})
+	if h.Len() != 3 {
+		t.Errorf("len: %v", h.Len())
+	}
+	actual := h.Nearest([]float64{1.01, 0, 0}, 0.05)
+	if actual != 1 {
+		t.Errorf("nearest: %v", actual)
+	}
+	actual = h.Nearest([]float64{0.995, 0, 0}, 0.05)
+	if actual != 0 {
+		t.Errorf("nearest across cells: %v", actual)
+	}
+	actual = h.Nearest([]float64{-0.4, -0.4, -0.4}, 0.1)
+	if actual != -1 {
+		t.Errorf("nearest outside radius: %v", actual)
+	}
+	actual = h.Nearest([]float64{-0.4, -0.4, -0.4}, 0.2)
+	if actual != 2 {
+		t.Errorf("nearest negative cell: %v", actual)
+	}
+	if p := h.Point(Vec3Create(), 1); !testSlice(p, []float64{1.02, 0, 0}) {
+		t.Errorf("point: %v", p)
+	}
+}
+
+func TestSpatialHashWithin(t *testing.T) {
+	h := NewSpatialHash(0.5)
+	h.Insert([]float64{0, 0, 2})
+	h.Insert([]float64{0, 1, 0})
+	h.Insert([]float64{0, 0, -1})
+	h.Insert([]float64{3, 0, 0})
+	actual := h.Within([]float64{0, 0, 0}, 2)
+	if !testIntSlice(actual, []int{1, 2, 0}) {
+		t.Errorf("within: %v", actual)
+	}
+	actual = h.Within([]float64{0, 0, 0}, 100)
+	if !testIntSlice(actual, []int{1, 2, 0, 3}) {
+		t.Errorf("within large radius: %v", actual)
+	}
+	if actual = h.Within([]float64{0, 0, 0}, -1); len(actual) != 0 {
+		t.Errorf("within negative radius: %v", actual)
+	}
+}
+
+func TestSpatialHashUnbounded(t *testing.T) {
+	h := NewSpatialHash(0.5)
+	h.Insert([]float64{3, 0, 0})
+	h.Insert([]float64{0, -2, 0})
+	h.Insert([]float64{1e300, 0, 0})
+	actual := h.Nearest([]float64{0, 0, 0}, math.Inf(1))
+	if actual != 1 {
+		t.Errorf("nearest infinite radius: %v", actual)
+	}
+	indices := h.Within([]float64{0, 0, 0}, 1e30)
+	if !testIntSlice(indices, []int{1, 0}) {
+		t.Errorf("within huge radius: %v", indices)
+	}
+	indices = h.Within([]float64{0, 0, 0}, math.Inf(1))
+	if !testIntSlice(indices, []int{1, 0, 2}) {
+		t.Errorf("within infinite radius: %v", indices)
+	}
+	actual = h.Nearest([]float64{1e300, 1, 0}, 2)
+	if actual != 2 {
+		t.Errorf("nearest huge coordinates: %v", actual)
+	}
+}
+
+func TestSpatialHashRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	h := NewSpatialHash(0.1)
+	points := make([][]float64, 500)
+	for i := range points {
+		points[i] = []float64{r.Float64(), r.Float64(), r.Float64()}
+		h.Insert(points[i])
+	}
+	for k := 0; k < 100; k++ {
+		p := []float64{r.Float64(), r.Float64(), r.Float64()}
+		expect, min := -1, math.Inf(1)
+		for i, q := range points {
+			if d := Vec3Distance(p, q); d <= 0.15 && d < min {
+				expect, min = i, d
+			}
+		}
+		if actual := h.Nearest(p, 0.15); actual != expect {
+			t.Fatalf("random nearest %v: %v", expect, actual)
+		}
+	}
+}
+
+func TestNewSpatialHashInvalid(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("zero cell size should panic")
+		}
+	}()
+	NewSpatialHash(0)
+}
@@ -0,0 +1,166 @@
+package glmatrix
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ReadSTL reads an ASCII or binary STL file into a mesh. STL files do not
+// share vertices, so every triangle has its own three vertices whose normals
+// are the facet normal. A zero facet normal is replaced by the normal
+// calculated from the winding of the triangle. The encoding is detected from
+// the size of the file since binary files may also start with "solid".
+func ReadSTL(r io.Reader) (*Mesh, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) >= 84 {
+		n := binary.LittleEndian.Uint32(data[80:])
+		if uint64(len(data)) == 84+50*uint64(n) {
+			return readBinarySTL(data[84:], int(n)), nil
+		}
+	}
+	if !bytes.HasPrefix(bytes.TrimLeft(data, " \t\r\n"), []byte("solid")) {
+		return nil, fmt.Errorf("glmatrix: invalid STL: neither a binary file of %v bytes nor an ASCII file", len(data))
+	}
+	return readASCIISTL(data)
+}
+
+func readBinarySTL(data []byte, n int) *Mesh {
+	b := &meshAttributes{mesh: &Mesh{}}
+	values := make([]float64, 12)
+	for i := 0; i < n; i++ {
+		BinaryFormat{Float32: true}.decode(values, data[i*50:i*50+48])
+		addSTLFacet(b, values[0:3], values[3:12])
+	}
+	return b.finish()
+}
+
+func readASCIISTL(data []byte) (*Mesh, error) {
+	b := &meshAttributes{mesh: &Mesh{}}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	scanner.Split(bufio.ScanWords)
+	errorf := func(format string, args ...interface{}) error {
+		return fmt.Errorf("glmatrix: invalid STL: facet %v: %v", b.mesh.TriangleCount(), fmt.Sprintf(format, args...))
+	}
+	numbers := func(out []float64) error {
+		for i := range out {
+			if !scanner.Scan() {
+				return errorf("unexpected end of file")
+			}
+			v, err := strconv.ParseFloat(scanner.Text(), 64)
+			if err != nil {
+				return errorf("invalid number %q", scanner.Text())
+			}
+			out[i] = v
+		}
+		return nil
+	}
+
+	normal := Vec3Create()
+	var vertices []float64
+	inFacet := false
+	for scanner.Scan() {
+		switch strings.ToLower(scanner.Text()) {
+		case "facet":
+			if inFacet {
+				return nil, errorf("missing endfacet")
+			}
+			inFacet = true
+			vertices = vertices[:0]
+			Vec3Zero(normal)
+		case "normal":
+			if err := numbers(normal); err != nil {
+				return nil, err
+			}
+		case "vertex":
+			if !inFacet {
+				return nil, errorf("vertex outside of a facet")
+			}
+			vertices = append(vertices, 0, 0, 0)
+			if err := numbers(vertices[len(vertices)-3:]); err != nil {
+				return nil, err
+			}
+		case "endfacet":
+			if !inFacet || len(vertices) < 9 {
+				return nil, errorf("facet needs 3 vertices but has %v", len(vertices)/3)
+			}
+			inFacet = false
+			for i := 6; i < len(vertices); i += 3 {
+				triangle := append(append(append([]float64{}, vertices[0:3]...), vertices[i-3:i]...), vertices[i:i+3]...)
+				addSTLFacet(b, normal, triangle)
+			}
+		}
+	}
+	if inFacet {
+		return nil, errorf("missing endfacet")
+	}
+	return b.finish(), nil
+}
+
+// addSTLFacet adds a triangle whose vertices share the facet normal
+func addSTLFacet(b *meshAttributes, normal, triangle []float64) {
+	if Vec3SquaredLength(normal) == 0 {
+		ab := Vec3Subtract(Vec3Create(), triangle[3:6], triangle[0:3])
+		ac := Vec3Subtract(Vec3Create(), triangle[6:9], triangle[0:3])
+		normal = Vec3Normalize(ab, Vec3Cross(ab, ab, ac))
+	}
+	b.addPolygon([]int{
+		b.addVertex(triangle[0:3], normal, nil),
+		b.addVertex(triangle[3:6], normal, nil),
+		b.addVertex(triangle[6:9], normal, nil),
+	})
+}
+
+// WriteSTL writes the triangles of a mesh as an STL file. The facet normals
+// are calculated from the winding of the triangles and the normals and uvs of
+// the mesh are not written.
+func WriteSTL(w io.Writer, m *Mesh, encoding MeshEncoding) error {
+	checkEncoding(encoding)
+	if err := m.validate(); err != nil {
+		return err
+	}
+	if uint64(m.TriangleCount()) > math.MaxUint32 {
+		return fmt.Errorf("glmatrix: %v triangles are too many for STL", m.TriangleCount())
+	}
+	bw := bufio.NewWriter(w)
+	if encoding == MeshBinary {
+		header := make([]byte, 84)
+		copy(header, "glmatrix")
+		binary.LittleEndian.PutUint32(header[80:], uint32(m.TriangleCount()))
+		bw.Write(header)
+	} else {
+		bw.WriteString("solid glmatrix\n")
+	}
+
+	normal := Vec3Create()
+	values := make([]float64, 12)
+	var buf []byte
+	for i := 0; i < m.TriangleCount(); i++ {
+		copy(values, m.triangleNormal(normal, i))
+		for j := 0; j < 3; j++ {
+			copy(values[3+j*3:], m.Positions[m.Indices[i*3+j]*3:m.Indices[i*3+j]*3+3])
+		}
+		if encoding == MeshBinary {
+			buf = BinaryFormat{Float32: true}.Append(buf[:0], values)
+			bw.Write(append(buf, 0, 0))
+			continue
+		}
+		fmt.Fprintf(bw, "  facet normal %v %v %v\n    outer loop\n", formatFloat(values[0]), formatFloat(values[1]), formatFloat(values[2]))
+		for j := 3; j < 12; j += 3 {
+			fmt.Fprintf(bw, "      vertex %v %v %v\n", formatFloat(values[j]), formatFloat(values[j+1]), formatFloat(values[j+2]))
+		}
+		bw.WriteString("    endloop\n  endfacet\n")
+	}
+	if encoding == MeshASCII {
+		bw.WriteString("endsolid glmatrix\n")
+	}
+	return bw.Flush()
+}
@@ -0,0 +1,133 @@
+package glmatrix
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestReadSTL(t *testing.T) {
+	src := `solid quad
+  facet normal 0 0 0
+    outer loop
+      vertex 0 0 0
+      vertex 1 0 0
+      vertex 1 1 0
+    endloop
+  endfacet
+  FACET NORMAL 0 0 -1
+    OUTER LOOP
+      VERTEX 0 0 0
+      VERTEX 1 1 0
+      VERTEX 0 1 0
+    ENDLOOP
+  ENDFACET
+endsolid quad
+`
+	m, err := ReadSTL(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("read stl: %v", err)
+	}
+	if !testSlice(m.Positions, []float64{0, 0, 0, 1, 0, 0, 1, 1, 0, 0, 0, 0, 1, 1, 0, 0, 1, 0}) ||
+		!testSlice(m.Normals, []float64{0, 0, 1, 0, 0, 1, 0, 0, 1, 0, 0, -1, 0, 0, -1, 0, 0, -1}) ||
+		m.UVs != nil || !testIntSlice(m.Indices, []int{0, 1, 2, 3, 4, 5}) {
+		t.Errorf("read stl: %v", m)
+	}
+}
+
+func TestReadSTLMissingNormal(t *testing.T) {
+	// the second facet must not inherit the normal of the first
+	src := `solid quad
+  facet normal 0 0 -1
+    outer loop
+      vertex 0 0 0
+      vertex 1 1 0
+      vertex 1 0 0
+    endloop
+  endfacet
+  facet
+    outer loop
+      vertex 0 0 0
+      vertex 1 1 0
+      vertex 0 1 0
+    endloop
+  endfacet
+endsolid quad
+`
+	m, err := ReadSTL(strings.NewReader(src))
+	if err != nil {
+		t.Fatalf("read stl: %v", err)
+	}
+	if !testSlice(m.Normals[9:18], []float64{0, 0, 1, 0, 0, 1, 0, 0, 1}) {
+		t.Errorf("read stl missing normal: %v", m.Normals)
+	}
+}
+
+func TestReadSTLErrors(t *testing.T) {
+	cases := map[string]string{
+		"": "neither a binary file of 0 bytes nor an ASCII file",
+		"solid\nfacet normal 0 0 1\nouter loop\nvertex 0 0 0\n": "facet 0: missing endfacet",
+		"solid\nfacet normal 0 0 1\nvertex 0 0 0\nendfacet\n":   "facet 0: facet needs 3 vertices but has 1",
+		"solid\nfacet normal 0 0 1\nvertex 0 0 x\n":             `facet 0: invalid number "x"`,
+		"solid\nfacet normal 0 0\n":                             "facet 0: unexpected end of file",
+		"solid\nvertex 0 0 0\n":                                 "facet 0: vertex outside of a facet",
+		"solid\nfacet normal 0 0 1\nfacet normal 0 0 1\n":       "facet 0: missing endfacet",
+		string(make([]byte, 90)):                                "neither a binary file of 90 bytes nor an ASCII file",
+	}
+	for src, expect := range cases {
+		m, err := ReadSTL(strings.NewReader(src))
+		if m != nil || err == nil || !strings.Contains(err.Error(), expect) {
+			t.Errorf("read stl %q: %v", src, err)
+		}
+	}
+}
+
+func TestWriteSTL(t *testing.T) {
+	var buf bytes.Buffer
+	m := testQuadMesh()
+	m.Indices = m.Indices[:3]
+	if err := WriteSTL(&buf, m, MeshASCII); err != nil {
+		t.Fatalf("write stl: %v", err)
+	}
+	expect := "solid glmatrix\n  facet normal 0 0 1\n    outer loop\n" +
+		"      vertex 0 0 0\n      vertex 1 0 0\n      vertex 1 1 0\n" +
+		"    endloop\n  endfacet\nendsolid glmatrix\n"
+	if buf.String() != expect {
+		t.Errorf("write stl: %v", buf.String())
+	}
+
+	buf.Reset()
+	if err := WriteSTL(&buf, m, MeshBinary); err != nil || buf.Len() != 134 || !strings.HasPrefix(buf.String(), "glmatrix") {
+		t.Errorf("write stl binary: %v %v", buf.Len(), err)
+	}
+}
+
+func TestSTLRoundTrip(t *testing.T) {
+	for _, encoding := range []MeshEncoding{MeshASCII, MeshBinary} {
+		m := testQuadMesh()
+		m.Transform(Mat4FromZRotation(Mat4Create(), 0.3))
+		var buf bytes.Buffer
+		if err := WriteSTL(&buf, m, encoding); err != nil {
+			t.Fatalf("write stl %v: %v", encoding, err)
+		}
+		actual, err := ReadSTL(&buf)
+		if err != nil || actual.VertexCount() != 6 || actual.UVs != nil || !testIntSlice(actual.Indices, []int{0, 1, 2, 3, 4, 5}) {
+			t.Fatalf("stl round trip %v: %v %v", encoding, actual, err)
+		}
+		for i, index := range m.Indices {
+			if !testSliceTol(actual.Positions[i*3:i*3+3], m.Positions[index*3:index*3+3], 1e-6) ||
+				!testSliceTol(actual.Normals[i*3:i*3+3], m.Normals[index*3:index*3+3], 1e-6) {
+				t.Errorf("stl round trip %v vertex %v: %v", encoding, i, actual)
+			}
+		}
+	}
+}
+
+func TestWriteSTLEncoding(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("write stl unknown encoding")
+		}
+	}()
+	WriteSTL(&bytes.Buffer{}, testQuadMesh(), "utf8")
+}
@@ -0,0 +1,248 @@
+package glmatrix
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Mat2dFromSVG set a mat2d to the transformation of an SVG transform attribute,
+// e.g. "translate(10,20) rotate(45 5 5) skewX(10)". The transformations are
+// applied in order with Mat2dTranslate, Mat2dRotate, Mat2dScale, Mat2dSkewX,
+// Mat2dSkewY and Mat2dMultiply. An empty string or "none" is the identity.
+// It returns nil and an error describing the problem for a malformed attribute.
+func Mat2dFromSVG(out []float64, s string) ([]float64, error) {
+	m := Mat2dCreate()
+	p := &transformParser{kind: "SVG", s: s}
+	p.skipSeparators()
+	if strings.TrimSpace(s) == "none" {
+		p.pos = len(s)
+	}
+	for p.pos < len(s) {
+		name := p.name()
+		if name == "" {
+			return nil, p.errorf("expected a transform function")
+		}
+		args, err := p.args(name)
+		if err != nil {
+			return nil, err
+		}
+		if err := svgApply(m, name, args); err != nil {
+			return nil, p.errorf("%v", err)
+		}
+		p.skipSeparators()
+	}
+	return Mat2dCopy(out, m), nil
+}
+
+func svgApply(m []float64, name string, args []float64) error {
+	n := len(args)
+	switch name {
+	case "matrix":
+		if n != 6 {
+			break
+		}
+		Mat2dMultiply(m, m, args)
+		return nil
+	case "translate":
+		if n == 1 {
+			args = append(args, 0)
+		} else if n != 2 {
+			break
+		}
+		Mat2dTranslate(m, m, args)
+		return nil
+	case "scale":
+		if n == 1 {
+			args = append(args, args[0])
+		} else if n != 2 {
+			break
+		}
+		Mat2dScale(m, m, args)
+		return nil
+	case "rotate":
+		if n == 3 {
+			Mat2dTranslate(m, m, args[1:3])
+			Mat2dRotate(m, m, ToRadian(args[0]))
+			Mat2dTranslate(m, m, []float64{-args[1], -args[2]})
+			return nil
+		} else if n != 1 {
+			break
+		}
+		Mat2dRotate(m, m, ToRadian(args[0]))
+		return nil
+	case "skewX":
+		if n != 1 {
+			break
+		}
+		Mat2dSkewX(m, m, ToRadian(args[0]))
+		return nil
+	case "skewY":
+		if n != 1 {
+			break
+		}
+		Mat2dSkewY(m, m, ToRadian(args[0]))
+		return nil
+	default:
+		return fmt.Errorf("unknown transform function %q", name)
+	}
+	return fmt.Errorf("wrong number of arguments %v for %v", n, name)
+}
+
+// transformParser parses SVG and CSS transform lists
+type transformParser struct {
+	kind string
+	s    string
+	pos  int
+}
+
+func (p *transformParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("glmatrix: invalid %v transform %q at %v: %v", p.kind, p.s, p.pos, fmt.Sprintf(format, args...))
+}
+
+func (p *transformParser) skipSpaces() {
+	for p.pos < len(p.s) && strings.IndexByte(" \t\r\n", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+func (p *transformParser) skipSeparators() {
+	for p.pos < len(p.s) && strings.IndexByte(" \t\r\n,", p.s[p.pos]) >= 0 {
+		p.pos++
+	}
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// name parses an identifier, which may contain digits after the first letter
+func (p *transformParser) name() string {
+	start := p.pos
+	for p.pos < len(p.s) && (isLetter(p.s[p.pos]) || p.pos > start && p.s[p.pos] >= '0' && p.s[p.pos] <= '9') {
+		p.pos++
+	}
+	return p.s[start:p.pos]
+}
+
+// args parses a parenthesized list of numbers separated by spaces or commas
+func (p *transformParser) args(name string) ([]float64, error) {
+	p.skipSpaces()
+	if p.pos >= len(p.s) || p.s[p.pos] != '(' {
+		return nil, p.errorf("expected ( after %v", name)
+	}
+	p.pos++
+	var args []float64
+	for {
+		p.skipSpaces()
+		if p.pos < len(p.s) && p.s[p.pos] == ')' {
+			p.pos++
+			return args, nil
+		}
+		if len(args) > 0 && p.pos < len(p.s) && p.s[p.pos] == ',' {
+			p.pos++
+			p.skipSpaces()
+		}
+		v, ok := p.number()
+		if !ok {
+			if p.pos >= len(p.s) {
+				return nil, p.errorf("expected ) after the arguments of %v", name)
+			}
+			return nil, p.errorf("expected a number")
+		}
+		args = append(args, v)
+	}
+}
+
+// number parses a number, which may directly follow the previous one
+// if it starts with a sign or a dot, as in "10-5" or "0.5.5"
+func (p *transformParser) number() (float64, bool) {
+	start := p.pos
+	digits := func() int {
+		n := 0
+		for p.pos < len(p.s) && p.s[p.pos] >= '0' && p.s[p.pos] <= '9' {
+			p.pos++
+			n++
+		}
+		return n
+	}
+	if p.pos < len(p.s) && (p.s[p.pos] == '+' || p.s[p.pos] == '-') {
+		p.pos++
+	}
+	n := digits()
+	if p.pos < len(p.s) && p.s[p.pos] == '.' {
+		p.pos++
+		n += digits()
+	}
+	if n == 0 {
+		p.pos = start
+		return 0, false
+	}
+	if p.pos < len(p.s) && (p.s[p.pos] == 'e' || p.s[p.pos] == 'E') {
+		mark := p.pos
+		p.pos++
+		if p.pos < len(p.s) && (p.s[p.pos] == '+' || p.s[p.pos] == '-') {
+			p.pos++
+		}
+		if digits() == 0 {
+			p.pos = mark
+		}
+	}
+	v, err := strconv.ParseFloat(p.s[start:p.pos], 64)
+	if err != nil {
+		p.pos = start
+		return 0, false
+	}
+	return v, true
+}
+
+// Mat2dStrSVG returns the shortest SVG transform attribute of a mat2d among
+// translate, scale, rotate, a translate followed by a scale or a rotate, and
+// matrix. It returns an empty string for the identity.
+func Mat2dStrSVG(a []float64) string {
+	translate := ""
+	if !equals(a[4], 0) || !equals(a[5], 0) {
+		translate = "translate(" + formatDecimal(a[4])
+		if !equals(a[5], 0) {
+			translate += " " + formatDecimal(a[5])
+		}
+		translate += ")"
+	}
+
+	linear := ""
+	if equals(a[1], 0) && equals(a[2], 0) {
+		if !equals(a[0], 1) || !equals(a[3], 1) {
+			linear = "scale(" + formatDecimal(a[0])
+			if !equals(a[0], a[3]) {
+				linear += " " + formatDecimal(a[3])
+			}
+			linear += ")"
+		}
+	} else if equals(a[0], a[3]) && equals(a[1], -a[2]) && equals(a[0]*a[0]+a[1]*a[1], 1) {
+		linear = "rotate(" + formatDecimal(math.Atan2(a[1], a[0])/degree) + ")"
+	} else {
+		values := make([]string, 6)
+		for i := range values {
+			values[i] = formatDecimal(a[i])
+		}
+		return "matrix(" + strings.Join(values, " ") + ")"
+	}
+
+	if translate != "" && linear != "" {
+		return translate + " " + linear
+	}
+	return translate + linear
+}
+
+// formatDecimal formats a number without an exponent and without the rounding
+// noise of the decomposition
+func formatDecimal(v float64) string {
+	if math.Abs(v) < 1e6 {
+		v = math.Round(v*1e9) / 1e9
+	}
+	if v == 0 {
+		return "0"
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
@@ -0,0 +1,108 @@
+package glmatrix
+
+import (
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestMat2dSkew(t *testing.T) {
+	actual := Mat2dSkewX(Mat2dCreate(), Mat2dCreate(), math.Pi/4)
+	if !testSlice(actual, []float64{1, 0, 1, 1, 0, 0}) {
+		t.Errorf("skew x: %v", actual)
+	}
+	actual = Mat2dSkewY(Mat2dCreate(), Mat2dFromValues(1, 2, 3, 4, 5, 6), math.Pi/4)
+	expect := Mat2dMultiply(Mat2dCreate(), Mat2dFromValues(1, 2, 3, 4, 5, 6), []float64{1, 1, 0, 1, 0, 0})
+	if !testSlice(actual, expect) {
+		t.Errorf("skew y: %v", actual)
+	}
+}
+
+func TestMat2dFromSVG(t *testing.T) {
+	expect := Mat2dFromTranslation(Mat2dCreate(), []float64{10, 20})
+	Mat2dTranslate(expect, expect, []float64{5, 5})
+	Mat2dRotate(expect, expect, math.Pi/4)
+	Mat2dTranslate(expect, expect, []float64{-5, -5})
+	Mat2dSkewX(expect, expect, ToRadian(10))
+	Mat2dScale(expect, expect, []float64{2, 2})
+	Mat2dMultiply(expect, expect, []float64{1, 2, 3, 4, 5, 6})
+	actual, err := Mat2dFromSVG(Mat2dCreate(), "translate(10,20) rotate(45 5 5)\n\tskewX( 10 ),scale(2) matrix(1 2 3 4 5 6)")
+	if err != nil || !testSlice(actual, expect) {
+		t.Errorf("from svg: %v %v", actual, err)
+	}
+}
+
+func TestMat2dFromSVGNumbers(t *testing.T) {
+	actual, err := Mat2dFromSVG(Mat2dCreate(), "matrix(1-2.5.5,+4e1 -1E-1 .25)")
+	if err != nil || !testSlice(actual, []float64{1, -2.5, 0.5, 40, -0.1, 0.25}) {
+		t.Errorf("from svg numbers: %v %v", actual, err)
+	}
+	for _, s := range []string{"", "  ", "none"} {
+		actual, err = Mat2dFromSVG(Mat2dCreate(), s)
+		if err != nil || !testSlice(actual, Mat2dCreate()) {
+			t.Errorf("from svg %q: %v %v", s, actual, err)
+		}
+	}
+	actual, err = Mat2dFromSVG(Mat2dCreate(), "translate(5) scale(2 3) skewY(45)")
+	expect := Mat2dFromValues(2, 3, 0, 3, 5, 0)
+	if err != nil || !testSlice(actual, expect) {
+		t.Errorf("from svg defaults: %v %v", actual, err)
+	}
+}
+
+func TestMat2dFromSVGErrors(t *testing.T) {
+	cases := map[string]string{
+		"rotate(1 2)":        "wrong number of arguments 2 for rotate",
+		"translate(1, 2, 3)": "wrong number of arguments 3 for translate",
+		"spin(45)":           `unknown transform function "spin"`,
+		"scale 2":            "expected ( after scale",
+		"scale(2":            "expected ) after the arguments of scale",
+		"scale(2x)":          "expected a number",
+		"(1)":                "expected a transform function",
+	}
+	for s, expect := range cases {
+		actual, err := Mat2dFromSVG(Mat2dCreate(), s)
+		if actual != nil || err == nil || !strings.Contains(err.Error(), expect) {
+			t.Errorf("from svg %q: %v", s, err)
+		}
+	}
+}
+
+func TestMat2dStrSVG(t *testing.T) {
+	cases := map[string][]float64{
+		"":                              Mat2dCreate(),
+		"translate(10)":                 Mat2dFromTranslation(Mat2dCreate(), []float64{10, 0}),
+		"translate(10 -2.5)":            Mat2dFromTranslation(Mat2dCreate(), []float64{10, -2.5}),
+		"scale(2)":                      Mat2dFromScaling(Mat2dCreate(), []float64{2, 2}),
+		"scale(2 0.5)":                  Mat2dFromScaling(Mat2dCreate(), []float64{2, 0.5}),
+		"rotate(30)":                    Mat2dFromRotation(Mat2dCreate(), ToRadian(30)),
+		"rotate(-90)":                   Mat2dFromRotation(Mat2dCreate(), ToRadian(-90)),
+		"translate(1 2) rotate(45)":     Mat2dRotate(Mat2dCreate(), Mat2dFromTranslation(Mat2dCreate(), []float64{1, 2}), ToRadian(45)),
+		"translate(0 2) scale(3)":       Mat2dFromValues(3, 0, 0, 3, 0, 2),
+		"matrix(1 2 3 4 5 6)":           Mat2dFromValues(1, 2, 3, 4, 5, 6),
+		"matrix(1 0 0.176326981 1 0 0)": Mat2dSkewX(Mat2dCreate(), Mat2dCreate(), ToRadian(10)),
+	}
+	for expect, m := range cases {
+		if actual := Mat2dStrSVG(m); actual != expect {
+			t.Errorf("str svg %v: %v", expect, actual)
+		}
+	}
+}
+
+func TestMat2dSVGRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		m := Mat2dFromValues(r.Float64()*10-5, r.Float64()*10-5, r.Float64()*10-5, r.Float64()*10-5, r.Float64()*100, r.Float64()*100)
+		switch i % 3 {
+		case 0:
+			Mat2dFromRotation(m, r.Float64()*6)
+		case 1:
+			Mat2dRotate(m, Mat2dFromTranslation(m, []float64{r.Float64(), r.Float64()}), r.Float64()*6)
+		}
+		actual, err := Mat2dFromSVG(Mat2dCreate(), Mat2dStrSVG(m))
+		if err != nil || !Mat2dEquals(actual, m) {
+			t.Errorf("svg round trip %v: %v %v", Mat2dStrSVG(m), actual, err)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package glmatrix
+
+import "fmt"
+
+//go:generate go run swizzle_gen.go
+
+// Vec2Swizzle set out to the components of a vec2 selected by the mask.
+// The mask is a string of up to 4 letters from "xy", "rg" or "st",
+// e.g. "yx" or "xyxy", and out must hold len(mask) values.
+func Vec2Swizzle(out, a []float64, mask string) []float64 {
+	return swizzle(out, a, 2, mask)
+}
+
+// Vec3Swizzle set out to the components of a Vec3 selected by the mask.
+// The mask is a string of up to 4 letters from "xyz", "rgb" or "stp",
+// e.g. "zyx" or "xy", and out must hold len(mask) values.
+func Vec3Swizzle(out, a []float64, mask string) []float64 {
+	return swizzle(out, a, 3, mask)
+}
+
+// Vec4Swizzle set out to the components of a vec4 selected by the mask.
+// The mask is a string of up to 4 letters from "xyzw", "rgba" or "stpq",
+// e.g. "wzyx" or "xyz", and out must hold len(mask) values.
+func Vec4Swizzle(out, a []float64, mask string) []float64 {
+	return swizzle(out, a, 4, mask)
+}
+
+func swizzle(out, a []float64, size int, mask string) []float64 {
+	if len(mask) == 0 || len(mask) > 4 {
+		panic(fmt.Sprintf("Swizzle mask must have 1 to 4 components but got %q", mask))
+	}
+	var v [4]float64
+	for i := 0; i < len(mask); i++ {
+		j := swizzleIndex(mask[i])
+		if j < 0 || j >= size {
+			panic(fmt.Sprintf("Invalid swizzle mask %q for a vector of size %v", mask, size))
+		}
+		v[i] = a[j]
+	}
+	copy(out, v[:len(mask)])
+	return out
+}
+
+func swizzleIndex(c byte) int {
+	switch c {
+	case 'x', 'r', 's':
+		return 0
+	case 'y', 'g', 't':
+		return 1
+	case 'z', 'b', 'p':
+		return 2
+	case 'w', 'a', 'q':
+		return 3
+	}
+	return -1
+}
+
+// Vec3FromVec2Z set the components of a Vec3 from a vec2 and a z value
+func Vec3FromVec2Z(out, xy []float64, z float64) []float64 {
+	x, y := xy[0], xy[1]
+	out[0] = x
+	out[1] = y
+	out[2] = z
+	return out
+}
+
+// Vec3FromXVec2 set the components of a Vec3 from an x value and a vec2
+func Vec3FromXVec2(out []float64, x float64, yz []float64) []float64 {
+	y, z := yz[0], yz[1]
+	out[0] = x
+	out[1] = y
+	out[2] = z
+	return out
+}
+
+// Vec4FromVec3W set the components of a vec4 from a Vec3 and a w value
+func Vec4FromVec3W(out, xyz []float64, w float64) []float64 {
+	x, y, z := xyz[0], xyz[1], xyz[2]
+	out[0] = x
+	out[1] = y
+	out[2] = z
+	out[3] = w
+	return out
+}
+
+// Vec4FromXVec3 set the components of a vec4 from an x value and a Vec3
+func Vec4FromXVec3(out []float64, x float64, yzw []float64) []float64 {
+	y, z, w := yzw[0], yzw[1], yzw[2]
+	out[0] = x
+	out[1] = y
+	out[2] = z
+	out[3] = w
+	return out
+}
+
+// Vec4FromVec2Vec2 set the components of a vec4 from two vec2
+func Vec4FromVec2Vec2(out, xy, zw []float64) []float64 {
+	x, y, z, w := xy[0], xy[1], zw[0], zw[1]
+	out[0] = x
+	out[1] = y
+	out[2] = z
+	out[3] = w
+	return out
+}
+
+// Vec4FromVec2ZW set the components of a vec4 from a vec2 and z and w values
+func Vec4FromVec2ZW(out, xy []float64, z, w float64) []float64 {
+	x, y := xy[0], xy[1]
+	out[0] = x
+	out[1] = y
+	out[2] = z
+	out[3] = w
+	return out
+}
+
+// Vec4FromXVec2W set the components of a vec4 from an x value, a vec2 and a w value
+func Vec4FromXVec2W(out []float64, x float64, yz []float64, w float64) []float64 {
+	y, z := yz[0], yz[1]
+	out[0] = x
+	out[1] = y
+	out[2] = z
+	out[3] = w
+	return out
+}
+
+// Vec4FromXYVec2 set the components of a vec4 from x and y values and a vec2
+func Vec4FromXYVec2(out []float64, x, y float64, zw []float64) []float64 {
+	z, w := zw[0], zw[1]
+	out[0] = x
+	out[1] = y
+	out[2] = z
+	out[3] = w
+	return out
+}
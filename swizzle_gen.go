@@ -0,0 +1,68 @@
+//go:build ignore
+
+// This program generates swizzles.go. Invoke it as
+//
+//	go generate
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+)
+
+var components = "xyzw"
+
+var typeNames = map[int]string{2: "vec2", 3: "Vec3", 4: "vec4"}
+
+func main() {
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by swizzle_gen.go. DO NOT EDIT.\n\n")
+	buf.WriteString("package glmatrix\n")
+	for size := 2; size <= 4; size++ {
+		for n := 2; n <= 4; n++ {
+			masks := []string{""}
+			for i := 0; i < n; i++ {
+				var next []string
+				for _, m := range masks {
+					for j := 0; j < size; j++ {
+						next = append(next, m+components[j:j+1])
+					}
+				}
+				masks = next
+			}
+			for _, m := range masks {
+				writeSwizzle(&buf, size, m)
+			}
+		}
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("swizzles.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func writeSwizzle(buf *bytes.Buffer, size int, mask string) {
+	name := fmt.Sprintf("Vec%d%s", size, strings.ToUpper(mask))
+	names := strings.Split(mask, "")
+	fmt.Fprintf(buf, "\n// %s set out to the %s components of a %s as a %s\n",
+		name, strings.Join(names, ", "), typeNames[size], typeNames[len(mask)])
+	fmt.Fprintf(buf, "func %s(out, a []float64) []float64 {\n", name)
+	vars := make([]string, len(mask))
+	values := make([]string, len(mask))
+	for i := range mask {
+		vars[i] = fmt.Sprintf("v%d", i)
+		values[i] = fmt.Sprintf("a[%d]", strings.IndexByte(components, mask[i]))
+	}
+	fmt.Fprintf(buf, "\t%s := %s\n", strings.Join(vars, ", "), strings.Join(values, ", "))
+	for i := range mask {
+		fmt.Fprintf(buf, "\tout[%d] = %s\n", i, vars[i])
+	}
+	buf.WriteString("\treturn out\n}\n")
+}
@@ -0,0 +1,117 @@
+package glmatrix
+
+import "testing"
+
+func TestVec3Swizzle(t *testing.T) {
+	actual := Vec3Swizzle(Vec3Create(), vec3A, "zxy")
+	if !testSlice(actual, []float64{3, 1, 2}) {
+		t.Errorf("swizzle: %v", actual)
+	}
+	actual = Vec3Swizzle(Vec2Create(), vec3A, "bg")
+	if !testSlice(actual, []float64{3, 2}) {
+		t.Errorf("swizzle rgba: %v", actual)
+	}
+	actual = Vec3Swizzle(Vec4Create(), vec3A, "stpp")
+	if !testSlice(actual, []float64{1, 2, 3, 3}) {
+		t.Errorf("swizzle stpq: %v", actual)
+	}
+}
+
+func TestSwizzleInPlace(t *testing.T) {
+	a := []float64{1, 2, 3, 4}
+	actual := Vec4Swizzle(a, a, "wzyx")
+	if !testSlice(actual, []float64{4, 3, 2, 1}) {
+		t.Errorf("swizzle in place: %v", actual)
+	}
+	a = []float64{1, 2, 3}
+	actual = Vec3ZXY(a, a)
+	if !testSlice(actual, []float64{3, 1, 2}) {
+		t.Errorf("named swizzle in place: %v", actual)
+	}
+}
+
+func TestSwizzleInvalidMask(t *testing.T) {
+	for _, mask := range []string{"", "xw", "xyzwx", "xa"} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("invalid mask %q: no panic", mask)
+				}
+			}()
+			Vec3Swizzle(Vec4Create(), vec3A, mask)
+		}()
+	}
+}
+
+func TestNamedSwizzle(t *testing.T) {
+	actual := Vec2YX(Vec2Create(), vec2A)
+	if !testSlice(actual, []float64{2, 1}) {
+		t.Errorf("yx: %v", actual)
+	}
+	actual = Vec3XZY(Vec3Create(), vec3A)
+	if !testSlice(actual, []float64{1, 3, 2}) {
+		t.Errorf("xzy: %v", actual)
+	}
+	actual = Vec3XY(Vec2Create(), vec3A)
+	if !testSlice(actual, []float64{1, 2}) {
+		t.Errorf("xy: %v", actual)
+	}
+	actual = Vec4XYZ(Vec3Create(), vec4A)
+	if !testSlice(actual, []float64{1, 2, 3}) {
+		t.Errorf("xyz: %v", actual)
+	}
+	actual = Vec2XYXY(Vec4Create(), vec2A)
+	if !testSlice(actual, []float64{1, 2, 1, 2}) {
+		t.Errorf("xyxy: %v", actual)
+	}
+	actual = Vec4WZYX(Vec4Create(), vec4A)
+	if !testSlice(actual, []float64{4, 3, 2, 1}) {
+		t.Errorf("wzyx: %v", actual)
+	}
+}
+
+func TestVec3FromVec2(t *testing.T) {
+	actual := Vec3FromVec2Z(Vec3Create(), vec2A, 5)
+	if !testSlice(actual, []float64{1, 2, 5}) {
+		t.Errorf("from vec2 z: %v", actual)
+	}
+	actual = Vec3FromXVec2(Vec3Create(), 5, vec2A)
+	if !testSlice(actual, []float64{5, 1, 2}) {
+		t.Errorf("from x vec2: %v", actual)
+	}
+}
+
+func TestVec4FromSmaller(t *testing.T) {
+	actual := Vec4FromVec3W(Vec4Create(), vec3A, 1)
+	if !testSlice(actual, []float64{1, 2, 3, 1}) {
+		t.Errorf("from vec3 w: %v", actual)
+	}
+	actual = Vec4FromXVec3(Vec4Create(), 0, vec3A)
+	if !testSlice(actual, []float64{0, 1, 2, 3}) {
+		t.Errorf("from x vec3: %v", actual)
+	}
+	actual = Vec4FromVec2Vec2(Vec4Create(), vec2A, vec2B)
+	if !testSlice(actual, []float64{1, 2, 3, 4}) {
+		t.Errorf("from vec2 vec2: %v", actual)
+	}
+	actual = Vec4FromVec2ZW(Vec4Create(), vec2A, 5, 6)
+	if !testSlice(actual, []float64{1, 2, 5, 6}) {
+		t.Errorf("from vec2 z w: %v", actual)
+	}
+	actual = Vec4FromXVec2W(Vec4Create(), 5, vec2A, 6)
+	if !testSlice(actual, []float64{5, 1, 2, 6}) {
+		t.Errorf("from x vec2 w: %v", actual)
+	}
+	actual = Vec4FromXYVec2(Vec4Create(), 5, 6, vec2A)
+	if !testSlice(actual, []float64{5, 6, 1, 2}) {
+		t.Errorf("from x y vec2: %v", actual)
+	}
+}
+
+func TestVec4FromVec3WInPlace(t *testing.T) {
+	a := []float64{1, 2, 3, 0}
+	actual := Vec4FromVec3W(a, a, 1)
+	if !testSlice(actual, []float64{1, 2, 3, 1}) {
+		t.Errorf("in place: %v", actual)
+	}
+}
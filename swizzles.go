@@ -0,0 +1,4656 @@
+// Code generated by swizzle_gen.go. DO NOT EDIT.
+
+package glmatrix
+
+// Vec2XX set out to the x, x components of a vec2 as a vec2
+func Vec2XX(out, a []float64) []float64 {
+	v0, v1 := a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec2XY set out to the x, y components of a vec2 as a vec2
+func Vec2XY(out, a []float64) []float64 {
+	v0, v1 := a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec2YX set out to the y, x components of a vec2 as a vec2
+func Vec2YX(out, a []float64) []float64 {
+	v0, v1 := a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec2YY set out to the y, y components of a vec2 as a vec2
+func Vec2YY(out, a []float64) []float64 {
+	v0, v1 := a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec2XXX set out to the x, x, x components of a vec2 as a Vec3
+func Vec2XXX(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec2XXY set out to the x, x, y components of a vec2 as a Vec3
+func Vec2XXY(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec2XYX set out to the x, y, x components of a vec2 as a Vec3
+func Vec2XYX(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec2XYY set out to the x, y, y components of a vec2 as a Vec3
+func Vec2XYY(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec2YXX set out to the y, x, x components of a vec2 as a Vec3
+func Vec2YXX(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec2YXY set out to the y, x, y components of a vec2 as a Vec3
+func Vec2YXY(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec2YYX set out to the y, y, x components of a vec2 as a Vec3
+func Vec2YYX(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec2YYY set out to the y, y, y components of a vec2 as a Vec3
+func Vec2YYY(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec2XXXX set out to the x, x, x, x components of a vec2 as a vec4
+func Vec2XXXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec2XXXY set out to the x, x, x, y components of a vec2 as a vec4
+func Vec2XXXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec2XXYX set out to the x, x, y, x components of a vec2 as a vec4
+func Vec2XXYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec2XXYY set out to the x, x, y, y components of a vec2 as a vec4
+func Vec2XXYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec2XYXX set out to the x, y, x, x components of a vec2 as a vec4
+func Vec2XYXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec2XYXY set out to the x, y, x, y components of a vec2 as a vec4
+func Vec2XYXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec2XYYX set out to the x, y, y, x components of a vec2 as a vec4
+func Vec2XYYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec2XYYY set out to the x, y, y, y components of a vec2 as a vec4
+func Vec2XYYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec2YXXX set out to the y, x, x, x components of a vec2 as a vec4
+func Vec2YXXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec2YXXY set out to the y, x, x, y components of a vec2 as a vec4
+func Vec2YXXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec2YXYX set out to the y, x, y, x components of a vec2 as a vec4
+func Vec2YXYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec2YXYY set out to the y, x, y, y components of a vec2 as a vec4
+func Vec2YXYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec2YYXX set out to the y, y, x, x components of a vec2 as a vec4
+func Vec2YYXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec2YYXY set out to the y, y, x, y components of a vec2 as a vec4
+func Vec2YYXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec2YYYX set out to the y, y, y, x components of a vec2 as a vec4
+func Vec2YYYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec2YYYY set out to the y, y, y, y components of a vec2 as a vec4
+func Vec2YYYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XX set out to the x, x components of a Vec3 as a vec2
+func Vec3XX(out, a []float64) []float64 {
+	v0, v1 := a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec3XY set out to the x, y components of a Vec3 as a vec2
+func Vec3XY(out, a []float64) []float64 {
+	v0, v1 := a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec3XZ set out to the x, z components of a Vec3 as a vec2
+func Vec3XZ(out, a []float64) []float64 {
+	v0, v1 := a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec3YX set out to the y, x components of a Vec3 as a vec2
+func Vec3YX(out, a []float64) []float64 {
+	v0, v1 := a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec3YY set out to the y, y components of a Vec3 as a vec2
+func Vec3YY(out, a []float64) []float64 {
+	v0, v1 := a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec3YZ set out to the y, z components of a Vec3 as a vec2
+func Vec3YZ(out, a []float64) []float64 {
+	v0, v1 := a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec3ZX set out to the z, x components of a Vec3 as a vec2
+func Vec3ZX(out, a []float64) []float64 {
+	v0, v1 := a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec3ZY set out to the z, y components of a Vec3 as a vec2
+func Vec3ZY(out, a []float64) []float64 {
+	v0, v1 := a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec3ZZ set out to the z, z components of a Vec3 as a vec2
+func Vec3ZZ(out, a []float64) []float64 {
+	v0, v1 := a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec3XXX set out to the x, x, x components of a Vec3 as a Vec3
+func Vec3XXX(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3XXY set out to the x, x, y components of a Vec3 as a Vec3
+func Vec3XXY(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3XXZ set out to the x, x, z components of a Vec3 as a Vec3
+func Vec3XXZ(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3XYX set out to the x, y, x components of a Vec3 as a Vec3
+func Vec3XYX(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3XYY set out to the x, y, y components of a Vec3 as a Vec3
+func Vec3XYY(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3XYZ set out to the x, y, z components of a Vec3 as a Vec3
+func Vec3XYZ(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3XZX set out to the x, z, x components of a Vec3 as a Vec3
+func Vec3XZX(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3XZY set out to the x, z, y components of a Vec3 as a Vec3
+func Vec3XZY(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3XZZ set out to the x, z, z components of a Vec3 as a Vec3
+func Vec3XZZ(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3YXX set out to the y, x, x components of a Vec3 as a Vec3
+func Vec3YXX(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3YXY set out to the y, x, y components of a Vec3 as a Vec3
+func Vec3YXY(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3YXZ set out to the y, x, z components of a Vec3 as a Vec3
+func Vec3YXZ(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3YYX set out to the y, y, x components of a Vec3 as a Vec3
+func Vec3YYX(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3YYY set out to the y, y, y components of a Vec3 as a Vec3
+func Vec3YYY(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3YYZ set out to the y, y, z components of a Vec3 as a Vec3
+func Vec3YYZ(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3YZX set out to the y, z, x components of a Vec3 as a Vec3
+func Vec3YZX(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3YZY set out to the y, z, y components of a Vec3 as a Vec3
+func Vec3YZY(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3YZZ set out to the y, z, z components of a Vec3 as a Vec3
+func Vec3YZZ(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3ZXX set out to the z, x, x components of a Vec3 as a Vec3
+func Vec3ZXX(out, a []float64) []float64 {
+	v0, v1, v2 := a[2], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3ZXY set out to the z, x, y components of a Vec3 as a Vec3
+func Vec3ZXY(out, a []float64) []float64 {
+	v0, v1, v2 := a[2], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3ZXZ set out to the z, x, z components of a Vec3 as a Vec3
+func Vec3ZXZ(out, a []float64) []float64 {
+	v0, v1, v2 := a[2], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3ZYX set out to the z, y, x components of a Vec3 as a Vec3
+func Vec3ZYX(out, a []float64) []float64 {
+	v0, v1, v2 := a[2], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3ZYY set out to the z, y, y components of a Vec3 as a Vec3
+func Vec3ZYY(out, a []float64) []float64 {
+	v0, v1, v2 := a[2], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3ZYZ set out to the z, y, z components of a Vec3 as a Vec3
+func Vec3ZYZ(out, a []float64) []float64 {
+	v0, v1, v2 := a[2], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3ZZX set out to the z, z, x components of a Vec3 as a Vec3
+func Vec3ZZX(out, a []float64) []float64 {
+	v0, v1, v2 := a[2], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3ZZY set out to the z, z, y components of a Vec3 as a Vec3
+func Vec3ZZY(out, a []float64) []float64 {
+	v0, v1, v2 := a[2], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3ZZZ set out to the z, z, z components of a Vec3 as a Vec3
+func Vec3ZZZ(out, a []float64) []float64 {
+	v0, v1, v2 := a[2], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec3XXXX set out to the x, x, x, x components of a Vec3 as a vec4
+func Vec3XXXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XXXY set out to the x, x, x, y components of a Vec3 as a vec4
+func Vec3XXXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XXXZ set out to the x, x, x, z components of a Vec3 as a vec4
+func Vec3XXXZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XXYX set out to the x, x, y, x components of a Vec3 as a vec4
+func Vec3XXYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XXYY set out to the x, x, y, y components of a Vec3 as a vec4
+func Vec3XXYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XXYZ set out to the x, x, y, z components of a Vec3 as a vec4
+func Vec3XXYZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XXZX set out to the x, x, z, x components of a Vec3 as a vec4
+func Vec3XXZX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XXZY set out to the x, x, z, y components of a Vec3 as a vec4
+func Vec3XXZY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XXZZ set out to the x, x, z, z components of a Vec3 as a vec4
+func Vec3XXZZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XYXX set out to the x, y, x, x components of a Vec3 as a vec4
+func Vec3XYXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XYXY set out to the x, y, x, y components of a Vec3 as a vec4
+func Vec3XYXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XYXZ set out to the x, y, x, z components of a Vec3 as a vec4
+func Vec3XYXZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XYYX set out to the x, y, y, x components of a Vec3 as a vec4
+func Vec3XYYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XYYY set out to the x, y, y, y components of a Vec3 as a vec4
+func Vec3XYYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XYYZ set out to the x, y, y, z components of a Vec3 as a vec4
+func Vec3XYYZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XYZX set out to the x, y, z, x components of a Vec3 as a vec4
+func Vec3XYZX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XYZY set out to the x, y, z, y components of a Vec3 as a vec4
+func Vec3XYZY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XYZZ set out to the x, y, z, z components of a Vec3 as a vec4
+func Vec3XYZZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XZXX set out to the x, z, x, x components of a Vec3 as a vec4
+func Vec3XZXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[2], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XZXY set out to the x, z, x, y components of a Vec3 as a vec4
+func Vec3XZXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[2], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XZXZ set out to the x, z, x, z components of a Vec3 as a vec4
+func Vec3XZXZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[2], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XZYX set out to the x, z, y, x components of a Vec3 as a vec4
+func Vec3XZYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[2], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XZYY set out to the x, z, y, y components of a Vec3 as a vec4
+func Vec3XZYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[2], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XZYZ set out to the x, z, y, z components of a Vec3 as a vec4
+func Vec3XZYZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[2], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XZZX set out to the x, z, z, x components of a Vec3 as a vec4
+func Vec3XZZX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[2], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XZZY set out to the x, z, z, y components of a Vec3 as a vec4
+func Vec3XZZY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[2], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3XZZZ set out to the x, z, z, z components of a Vec3 as a vec4
+func Vec3XZZZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[2], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YXXX set out to the y, x, x, x components of a Vec3 as a vec4
+func Vec3YXXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YXXY set out to the y, x, x, y components of a Vec3 as a vec4
+func Vec3YXXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YXXZ set out to the y, x, x, z components of a Vec3 as a vec4
+func Vec3YXXZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YXYX set out to the y, x, y, x components of a Vec3 as a vec4
+func Vec3YXYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YXYY set out to the y, x, y, y components of a Vec3 as a vec4
+func Vec3YXYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YXYZ set out to the y, x, y, z components of a Vec3 as a vec4
+func Vec3YXYZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YXZX set out to the y, x, z, x components of a Vec3 as a vec4
+func Vec3YXZX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YXZY set out to the y, x, z, y components of a Vec3 as a vec4
+func Vec3YXZY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YXZZ set out to the y, x, z, z components of a Vec3 as a vec4
+func Vec3YXZZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YYXX set out to the y, y, x, x components of a Vec3 as a vec4
+func Vec3YYXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YYXY set out to the y, y, x, y components of a Vec3 as a vec4
+func Vec3YYXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YYXZ set out to the y, y, x, z components of a Vec3 as a vec4
+func Vec3YYXZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YYYX set out to the y, y, y, x components of a Vec3 as a vec4
+func Vec3YYYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YYYY set out to the y, y, y, y components of a Vec3 as a vec4
+func Vec3YYYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YYYZ set out to the y, y, y, z components of a Vec3 as a vec4
+func Vec3YYYZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YYZX set out to the y, y, z, x components of a Vec3 as a vec4
+func Vec3YYZX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YYZY set out to the y, y, z, y components of a Vec3 as a vec4
+func Vec3YYZY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YYZZ set out to the y, y, z, z components of a Vec3 as a vec4
+func Vec3YYZZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YZXX set out to the y, z, x, x components of a Vec3 as a vec4
+func Vec3YZXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[2], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YZXY set out to the y, z, x, y components of a Vec3 as a vec4
+func Vec3YZXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[2], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YZXZ set out to the y, z, x, z components of a Vec3 as a vec4
+func Vec3YZXZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[2], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YZYX set out to the y, z, y, x components of a Vec3 as a vec4
+func Vec3YZYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[2], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YZYY set out to the y, z, y, y components of a Vec3 as a vec4
+func Vec3YZYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[2], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YZYZ set out to the y, z, y, z components of a Vec3 as a vec4
+func Vec3YZYZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[2], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YZZX set out to the y, z, z, x components of a Vec3 as a vec4
+func Vec3YZZX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[2], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YZZY set out to the y, z, z, y components of a Vec3 as a vec4
+func Vec3YZZY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[2], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3YZZZ set out to the y, z, z, z components of a Vec3 as a vec4
+func Vec3YZZZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[2], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZXXX set out to the z, x, x, x components of a Vec3 as a vec4
+func Vec3ZXXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[0], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZXXY set out to the z, x, x, y components of a Vec3 as a vec4
+func Vec3ZXXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[0], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZXXZ set out to the z, x, x, z components of a Vec3 as a vec4
+func Vec3ZXXZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[0], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZXYX set out to the z, x, y, x components of a Vec3 as a vec4
+func Vec3ZXYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[0], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZXYY set out to the z, x, y, y components of a Vec3 as a vec4
+func Vec3ZXYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[0], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZXYZ set out to the z, x, y, z components of a Vec3 as a vec4
+func Vec3ZXYZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[0], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZXZX set out to the z, x, z, x components of a Vec3 as a vec4
+func Vec3ZXZX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[0], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZXZY set out to the z, x, z, y components of a Vec3 as a vec4
+func Vec3ZXZY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[0], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZXZZ set out to the z, x, z, z components of a Vec3 as a vec4
+func Vec3ZXZZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[0], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZYXX set out to the z, y, x, x components of a Vec3 as a vec4
+func Vec3ZYXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[1], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZYXY set out to the z, y, x, y components of a Vec3 as a vec4
+func Vec3ZYXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[1], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZYXZ set out to the z, y, x, z components of a Vec3 as a vec4
+func Vec3ZYXZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[1], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZYYX set out to the z, y, y, x components of a Vec3 as a vec4
+func Vec3ZYYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[1], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZYYY set out to the z, y, y, y components of a Vec3 as a vec4
+func Vec3ZYYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[1], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZYYZ set out to the z, y, y, z components of a Vec3 as a vec4
+func Vec3ZYYZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[1], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZYZX set out to the z, y, z, x components of a Vec3 as a vec4
+func Vec3ZYZX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[1], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZYZY set out to the z, y, z, y components of a Vec3 as a vec4
+func Vec3ZYZY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[1], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZYZZ set out to the z, y, z, z components of a Vec3 as a vec4
+func Vec3ZYZZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[1], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZZXX set out to the z, z, x, x components of a Vec3 as a vec4
+func Vec3ZZXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[2], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZZXY set out to the z, z, x, y components of a Vec3 as a vec4
+func Vec3ZZXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[2], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZZXZ set out to the z, z, x, z components of a Vec3 as a vec4
+func Vec3ZZXZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[2], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZZYX set out to the z, z, y, x components of a Vec3 as a vec4
+func Vec3ZZYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[2], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZZYY set out to the z, z, y, y components of a Vec3 as a vec4
+func Vec3ZZYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[2], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZZYZ set out to the z, z, y, z components of a Vec3 as a vec4
+func Vec3ZZYZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[2], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZZZX set out to the z, z, z, x components of a Vec3 as a vec4
+func Vec3ZZZX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[2], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZZZY set out to the z, z, z, y components of a Vec3 as a vec4
+func Vec3ZZZY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[2], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec3ZZZZ set out to the z, z, z, z components of a Vec3 as a vec4
+func Vec3ZZZZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[2], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XX set out to the x, x components of a vec4 as a vec2
+func Vec4XX(out, a []float64) []float64 {
+	v0, v1 := a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec4XY set out to the x, y components of a vec4 as a vec2
+func Vec4XY(out, a []float64) []float64 {
+	v0, v1 := a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec4XZ set out to the x, z components of a vec4 as a vec2
+func Vec4XZ(out, a []float64) []float64 {
+	v0, v1 := a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec4XW set out to the x, w components of a vec4 as a vec2
+func Vec4XW(out, a []float64) []float64 {
+	v0, v1 := a[0], a[3]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec4YX set out to the y, x components of a vec4 as a vec2
+func Vec4YX(out, a []float64) []float64 {
+	v0, v1 := a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec4YY set out to the y, y components of a vec4 as a vec2
+func Vec4YY(out, a []float64) []float64 {
+	v0, v1 := a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec4YZ set out to the y, z components of a vec4 as a vec2
+func Vec4YZ(out, a []float64) []float64 {
+	v0, v1 := a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec4YW set out to the y, w components of a vec4 as a vec2
+func Vec4YW(out, a []float64) []float64 {
+	v0, v1 := a[1], a[3]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec4ZX set out to the z, x components of a vec4 as a vec2
+func Vec4ZX(out, a []float64) []float64 {
+	v0, v1 := a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec4ZY set out to the z, y components of a vec4 as a vec2
+func Vec4ZY(out, a []float64) []float64 {
+	v0, v1 := a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec4ZZ set out to the z, z components of a vec4 as a vec2
+func Vec4ZZ(out, a []float64) []float64 {
+	v0, v1 := a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec4ZW set out to the z, w components of a vec4 as a vec2
+func Vec4ZW(out, a []float64) []float64 {
+	v0, v1 := a[2], a[3]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec4WX set out to the w, x components of a vec4 as a vec2
+func Vec4WX(out, a []float64) []float64 {
+	v0, v1 := a[3], a[0]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec4WY set out to the w, y components of a vec4 as a vec2
+func Vec4WY(out, a []float64) []float64 {
+	v0, v1 := a[3], a[1]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec4WZ set out to the w, z components of a vec4 as a vec2
+func Vec4WZ(out, a []float64) []float64 {
+	v0, v1 := a[3], a[2]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec4WW set out to the w, w components of a vec4 as a vec2
+func Vec4WW(out, a []float64) []float64 {
+	v0, v1 := a[3], a[3]
+	out[0] = v0
+	out[1] = v1
+	return out
+}
+
+// Vec4XXX set out to the x, x, x components of a vec4 as a Vec3
+func Vec4XXX(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4XXY set out to the x, x, y components of a vec4 as a Vec3
+func Vec4XXY(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4XXZ set out to the x, x, z components of a vec4 as a Vec3
+func Vec4XXZ(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4XXW set out to the x, x, w components of a vec4 as a Vec3
+func Vec4XXW(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[0], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4XYX set out to the x, y, x components of a vec4 as a Vec3
+func Vec4XYX(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4XYY set out to the x, y, y components of a vec4 as a Vec3
+func Vec4XYY(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4XYZ set out to the x, y, z components of a vec4 as a Vec3
+func Vec4XYZ(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4XYW set out to the x, y, w components of a vec4 as a Vec3
+func Vec4XYW(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[1], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4XZX set out to the x, z, x components of a vec4 as a Vec3
+func Vec4XZX(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4XZY set out to the x, z, y components of a vec4 as a Vec3
+func Vec4XZY(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4XZZ set out to the x, z, z components of a vec4 as a Vec3
+func Vec4XZZ(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4XZW set out to the x, z, w components of a vec4 as a Vec3
+func Vec4XZW(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[2], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4XWX set out to the x, w, x components of a vec4 as a Vec3
+func Vec4XWX(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[3], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4XWY set out to the x, w, y components of a vec4 as a Vec3
+func Vec4XWY(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[3], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4XWZ set out to the x, w, z components of a vec4 as a Vec3
+func Vec4XWZ(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[3], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4XWW set out to the x, w, w components of a vec4 as a Vec3
+func Vec4XWW(out, a []float64) []float64 {
+	v0, v1, v2 := a[0], a[3], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4YXX set out to the y, x, x components of a vec4 as a Vec3
+func Vec4YXX(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4YXY set out to the y, x, y components of a vec4 as a Vec3
+func Vec4YXY(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4YXZ set out to the y, x, z components of a vec4 as a Vec3
+func Vec4YXZ(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4YXW set out to the y, x, w components of a vec4 as a Vec3
+func Vec4YXW(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[0], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4YYX set out to the y, y, x components of a vec4 as a Vec3
+func Vec4YYX(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4YYY set out to the y, y, y components of a vec4 as a Vec3
+func Vec4YYY(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4YYZ set out to the y, y, z components of a vec4 as a Vec3
+func Vec4YYZ(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4YYW set out to the y, y, w components of a vec4 as a Vec3
+func Vec4YYW(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[1], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4YZX set out to the y, z, x components of a vec4 as a Vec3
+func Vec4YZX(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4YZY set out to the y, z, y components of a vec4 as a Vec3
+func Vec4YZY(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4YZZ set out to the y, z, z components of a vec4 as a Vec3
+func Vec4YZZ(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4YZW set out to the y, z, w components of a vec4 as a Vec3
+func Vec4YZW(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[2], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4YWX set out to the y, w, x components of a vec4 as a Vec3
+func Vec4YWX(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[3], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4YWY set out to the y, w, y components of a vec4 as a Vec3
+func Vec4YWY(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[3], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4YWZ set out to the y, w, z components of a vec4 as a Vec3
+func Vec4YWZ(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[3], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4YWW set out to the y, w, w components of a vec4 as a Vec3
+func Vec4YWW(out, a []float64) []float64 {
+	v0, v1, v2 := a[1], a[3], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4ZXX set out to the z, x, x components of a vec4 as a Vec3
+func Vec4ZXX(out, a []float64) []float64 {
+	v0, v1, v2 := a[2], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4ZXY set out to the z, x, y components of a vec4 as a Vec3
+func Vec4ZXY(out, a []float64) []float64 {
+	v0, v1, v2 := a[2], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4ZXZ set out to the z, x, z components of a vec4 as a Vec3
+func Vec4ZXZ(out, a []float64) []float64 {
+	v0, v1, v2 := a[2], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4ZXW set out to the z, x, w components of a vec4 as a Vec3
+func Vec4ZXW(out, a []float64) []float64 {
+	v0, v1, v2 := a[2], a[0], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4ZYX set out to the z, y, x components of a vec4 as a Vec3
+func Vec4ZYX(out, a []float64) []float64 {
+	v0, v1, v2 := a[2], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4ZYY set out to the z, y, y components of a vec4 as a Vec3
+func Vec4ZYY(out, a []float64) []float64 {
+	v0, v1, v2 := a[2], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4ZYZ set out to the z, y, z components of a vec4 as a Vec3
+func Vec4ZYZ(out, a []float64) []float64 {
+	v0, v1, v2 := a[2], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4ZYW set out to the z, y, w components of a vec4 as a Vec3
+func Vec4ZYW(out, a []float64) []float64 {
+	v0, v1, v2 := a[2], a[1], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4ZZX set out to the z, z, x components of a vec4 as a Vec3
+func Vec4ZZX(out, a []float64) []float64 {
+	v0, v1, v2 := a[2], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4ZZY set out to the z, z, y components of a vec4 as a Vec3
+func Vec4ZZY(out, a []float64) []float64 {
+	v0, v1, v2 := a[2], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4ZZZ set out to the z, z, z components of a vec4 as a Vec3
+func Vec4ZZZ(out, a []float64) []float64 {
+	v0, v1, v2 := a[2], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4ZZW set out to the z, z, w components of a vec4 as a Vec3
+func Vec4ZZW(out, a []float64) []float64 {
+	v0, v1, v2 := a[2], a[2], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4ZWX set out to the z, w, x components of a vec4 as a Vec3
+func Vec4ZWX(out, a []float64) []float64 {
+	v0, v1, v2 := a[2], a[3], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4ZWY set out to the z, w, y components of a vec4 as a Vec3
+func Vec4ZWY(out, a []float64) []float64 {
+	v0, v1, v2 := a[2], a[3], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4ZWZ set out to the z, w, z components of a vec4 as a Vec3
+func Vec4ZWZ(out, a []float64) []float64 {
+	v0, v1, v2 := a[2], a[3], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4ZWW set out to the z, w, w components of a vec4 as a Vec3
+func Vec4ZWW(out, a []float64) []float64 {
+	v0, v1, v2 := a[2], a[3], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4WXX set out to the w, x, x components of a vec4 as a Vec3
+func Vec4WXX(out, a []float64) []float64 {
+	v0, v1, v2 := a[3], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4WXY set out to the w, x, y components of a vec4 as a Vec3
+func Vec4WXY(out, a []float64) []float64 {
+	v0, v1, v2 := a[3], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4WXZ set out to the w, x, z components of a vec4 as a Vec3
+func Vec4WXZ(out, a []float64) []float64 {
+	v0, v1, v2 := a[3], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4WXW set out to the w, x, w components of a vec4 as a Vec3
+func Vec4WXW(out, a []float64) []float64 {
+	v0, v1, v2 := a[3], a[0], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4WYX set out to the w, y, x components of a vec4 as a Vec3
+func Vec4WYX(out, a []float64) []float64 {
+	v0, v1, v2 := a[3], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4WYY set out to the w, y, y components of a vec4 as a Vec3
+func Vec4WYY(out, a []float64) []float64 {
+	v0, v1, v2 := a[3], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4WYZ set out to the w, y, z components of a vec4 as a Vec3
+func Vec4WYZ(out, a []float64) []float64 {
+	v0, v1, v2 := a[3], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4WYW set out to the w, y, w components of a vec4 as a Vec3
+func Vec4WYW(out, a []float64) []float64 {
+	v0, v1, v2 := a[3], a[1], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4WZX set out to the w, z, x components of a vec4 as a Vec3
+func Vec4WZX(out, a []float64) []float64 {
+	v0, v1, v2 := a[3], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4WZY set out to the w, z, y components of a vec4 as a Vec3
+func Vec4WZY(out, a []float64) []float64 {
+	v0, v1, v2 := a[3], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4WZZ set out to the w, z, z components of a vec4 as a Vec3
+func Vec4WZZ(out, a []float64) []float64 {
+	v0, v1, v2 := a[3], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4WZW set out to the w, z, w components of a vec4 as a Vec3
+func Vec4WZW(out, a []float64) []float64 {
+	v0, v1, v2 := a[3], a[2], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4WWX set out to the w, w, x components of a vec4 as a Vec3
+func Vec4WWX(out, a []float64) []float64 {
+	v0, v1, v2 := a[3], a[3], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4WWY set out to the w, w, y components of a vec4 as a Vec3
+func Vec4WWY(out, a []float64) []float64 {
+	v0, v1, v2 := a[3], a[3], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4WWZ set out to the w, w, z components of a vec4 as a Vec3
+func Vec4WWZ(out, a []float64) []float64 {
+	v0, v1, v2 := a[3], a[3], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4WWW set out to the w, w, w components of a vec4 as a Vec3
+func Vec4WWW(out, a []float64) []float64 {
+	v0, v1, v2 := a[3], a[3], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	return out
+}
+
+// Vec4XXXX set out to the x, x, x, x components of a vec4 as a vec4
+func Vec4XXXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XXXY set out to the x, x, x, y components of a vec4 as a vec4
+func Vec4XXXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XXXZ set out to the x, x, x, z components of a vec4 as a vec4
+func Vec4XXXZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XXXW set out to the x, x, x, w components of a vec4 as a vec4
+func Vec4XXXW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[0], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XXYX set out to the x, x, y, x components of a vec4 as a vec4
+func Vec4XXYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XXYY set out to the x, x, y, y components of a vec4 as a vec4
+func Vec4XXYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XXYZ set out to the x, x, y, z components of a vec4 as a vec4
+func Vec4XXYZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XXYW set out to the x, x, y, w components of a vec4 as a vec4
+func Vec4XXYW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[1], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XXZX set out to the x, x, z, x components of a vec4 as a vec4
+func Vec4XXZX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XXZY set out to the x, x, z, y components of a vec4 as a vec4
+func Vec4XXZY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XXZZ set out to the x, x, z, z components of a vec4 as a vec4
+func Vec4XXZZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XXZW set out to the x, x, z, w components of a vec4 as a vec4
+func Vec4XXZW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[2], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XXWX set out to the x, x, w, x components of a vec4 as a vec4
+func Vec4XXWX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[3], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XXWY set out to the x, x, w, y components of a vec4 as a vec4
+func Vec4XXWY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[3], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XXWZ set out to the x, x, w, z components of a vec4 as a vec4
+func Vec4XXWZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[3], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XXWW set out to the x, x, w, w components of a vec4 as a vec4
+func Vec4XXWW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[0], a[3], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XYXX set out to the x, y, x, x components of a vec4 as a vec4
+func Vec4XYXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XYXY set out to the x, y, x, y components of a vec4 as a vec4
+func Vec4XYXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XYXZ set out to the x, y, x, z components of a vec4 as a vec4
+func Vec4XYXZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XYXW set out to the x, y, x, w components of a vec4 as a vec4
+func Vec4XYXW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[0], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XYYX set out to the x, y, y, x components of a vec4 as a vec4
+func Vec4XYYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XYYY set out to the x, y, y, y components of a vec4 as a vec4
+func Vec4XYYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XYYZ set out to the x, y, y, z components of a vec4 as a vec4
+func Vec4XYYZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XYYW set out to the x, y, y, w components of a vec4 as a vec4
+func Vec4XYYW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[1], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XYZX set out to the x, y, z, x components of a vec4 as a vec4
+func Vec4XYZX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XYZY set out to the x, y, z, y components of a vec4 as a vec4
+func Vec4XYZY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XYZZ set out to the x, y, z, z components of a vec4 as a vec4
+func Vec4XYZZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XYZW set out to the x, y, z, w components of a vec4 as a vec4
+func Vec4XYZW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[2], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XYWX set out to the x, y, w, x components of a vec4 as a vec4
+func Vec4XYWX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[3], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XYWY set out to the x, y, w, y components of a vec4 as a vec4
+func Vec4XYWY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[3], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XYWZ set out to the x, y, w, z components of a vec4 as a vec4
+func Vec4XYWZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[3], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XYWW set out to the x, y, w, w components of a vec4 as a vec4
+func Vec4XYWW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[1], a[3], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XZXX set out to the x, z, x, x components of a vec4 as a vec4
+func Vec4XZXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[2], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XZXY set out to the x, z, x, y components of a vec4 as a vec4
+func Vec4XZXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[2], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XZXZ set out to the x, z, x, z components of a vec4 as a vec4
+func Vec4XZXZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[2], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XZXW set out to the x, z, x, w components of a vec4 as a vec4
+func Vec4XZXW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[2], a[0], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XZYX set out to the x, z, y, x components of a vec4 as a vec4
+func Vec4XZYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[2], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XZYY set out to the x, z, y, y components of a vec4 as a vec4
+func Vec4XZYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[2], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XZYZ set out to the x, z, y, z components of a vec4 as a vec4
+func Vec4XZYZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[2], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XZYW set out to the x, z, y, w components of a vec4 as a vec4
+func Vec4XZYW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[2], a[1], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XZZX set out to the x, z, z, x components of a vec4 as a vec4
+func Vec4XZZX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[2], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XZZY set out to the x, z, z, y components of a vec4 as a vec4
+func Vec4XZZY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[2], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XZZZ set out to the x, z, z, z components of a vec4 as a vec4
+func Vec4XZZZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[2], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XZZW set out to the x, z, z, w components of a vec4 as a vec4
+func Vec4XZZW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[2], a[2], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XZWX set out to the x, z, w, x components of a vec4 as a vec4
+func Vec4XZWX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[2], a[3], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XZWY set out to the x, z, w, y components of a vec4 as a vec4
+func Vec4XZWY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[2], a[3], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XZWZ set out to the x, z, w, z components of a vec4 as a vec4
+func Vec4XZWZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[2], a[3], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XZWW set out to the x, z, w, w components of a vec4 as a vec4
+func Vec4XZWW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[2], a[3], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XWXX set out to the x, w, x, x components of a vec4 as a vec4
+func Vec4XWXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[3], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XWXY set out to the x, w, x, y components of a vec4 as a vec4
+func Vec4XWXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[3], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XWXZ set out to the x, w, x, z components of a vec4 as a vec4
+func Vec4XWXZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[3], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XWXW set out to the x, w, x, w components of a vec4 as a vec4
+func Vec4XWXW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[3], a[0], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XWYX set out to the x, w, y, x components of a vec4 as a vec4
+func Vec4XWYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[3], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XWYY set out to the x, w, y, y components of a vec4 as a vec4
+func Vec4XWYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[3], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XWYZ set out to the x, w, y, z components of a vec4 as a vec4
+func Vec4XWYZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[3], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XWYW set out to the x, w, y, w components of a vec4 as a vec4
+func Vec4XWYW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[3], a[1], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XWZX set out to the x, w, z, x components of a vec4 as a vec4
+func Vec4XWZX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[3], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XWZY set out to the x, w, z, y components of a vec4 as a vec4
+func Vec4XWZY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[3], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XWZZ set out to the x, w, z, z components of a vec4 as a vec4
+func Vec4XWZZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[3], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XWZW set out to the x, w, z, w components of a vec4 as a vec4
+func Vec4XWZW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[3], a[2], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XWWX set out to the x, w, w, x components of a vec4 as a vec4
+func Vec4XWWX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[3], a[3], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XWWY set out to the x, w, w, y components of a vec4 as a vec4
+func Vec4XWWY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[3], a[3], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XWWZ set out to the x, w, w, z components of a vec4 as a vec4
+func Vec4XWWZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[3], a[3], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4XWWW set out to the x, w, w, w components of a vec4 as a vec4
+func Vec4XWWW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[0], a[3], a[3], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YXXX set out to the y, x, x, x components of a vec4 as a vec4
+func Vec4YXXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YXXY set out to the y, x, x, y components of a vec4 as a vec4
+func Vec4YXXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YXXZ set out to the y, x, x, z components of a vec4 as a vec4
+func Vec4YXXZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YXXW set out to the y, x, x, w components of a vec4 as a vec4
+func Vec4YXXW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[0], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YXYX set out to the y, x, y, x components of a vec4 as a vec4
+func Vec4YXYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YXYY set out to the y, x, y, y components of a vec4 as a vec4
+func Vec4YXYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YXYZ set out to the y, x, y, z components of a vec4 as a vec4
+func Vec4YXYZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YXYW set out to the y, x, y, w components of a vec4 as a vec4
+func Vec4YXYW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[1], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YXZX set out to the y, x, z, x components of a vec4 as a vec4
+func Vec4YXZX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YXZY set out to the y, x, z, y components of a vec4 as a vec4
+func Vec4YXZY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YXZZ set out to the y, x, z, z components of a vec4 as a vec4
+func Vec4YXZZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YXZW set out to the y, x, z, w components of a vec4 as a vec4
+func Vec4YXZW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[2], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YXWX set out to the y, x, w, x components of a vec4 as a vec4
+func Vec4YXWX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[3], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YXWY set out to the y, x, w, y components of a vec4 as a vec4
+func Vec4YXWY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[3], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YXWZ set out to the y, x, w, z components of a vec4 as a vec4
+func Vec4YXWZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[3], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YXWW set out to the y, x, w, w components of a vec4 as a vec4
+func Vec4YXWW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[0], a[3], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YYXX set out to the y, y, x, x components of a vec4 as a vec4
+func Vec4YYXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YYXY set out to the y, y, x, y components of a vec4 as a vec4
+func Vec4YYXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YYXZ set out to the y, y, x, z components of a vec4 as a vec4
+func Vec4YYXZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YYXW set out to the y, y, x, w components of a vec4 as a vec4
+func Vec4YYXW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[0], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YYYX set out to the y, y, y, x components of a vec4 as a vec4
+func Vec4YYYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YYYY set out to the y, y, y, y components of a vec4 as a vec4
+func Vec4YYYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YYYZ set out to the y, y, y, z components of a vec4 as a vec4
+func Vec4YYYZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YYYW set out to the y, y, y, w components of a vec4 as a vec4
+func Vec4YYYW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[1], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YYZX set out to the y, y, z, x components of a vec4 as a vec4
+func Vec4YYZX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YYZY set out to the y, y, z, y components of a vec4 as a vec4
+func Vec4YYZY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YYZZ set out to the y, y, z, z components of a vec4 as a vec4
+func Vec4YYZZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YYZW set out to the y, y, z, w components of a vec4 as a vec4
+func Vec4YYZW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[2], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YYWX set out to the y, y, w, x components of a vec4 as a vec4
+func Vec4YYWX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[3], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YYWY set out to the y, y, w, y components of a vec4 as a vec4
+func Vec4YYWY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[3], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YYWZ set out to the y, y, w, z components of a vec4 as a vec4
+func Vec4YYWZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[3], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YYWW set out to the y, y, w, w components of a vec4 as a vec4
+func Vec4YYWW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[1], a[3], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YZXX set out to the y, z, x, x components of a vec4 as a vec4
+func Vec4YZXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[2], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YZXY set out to the y, z, x, y components of a vec4 as a vec4
+func Vec4YZXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[2], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YZXZ set out to the y, z, x, z components of a vec4 as a vec4
+func Vec4YZXZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[2], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YZXW set out to the y, z, x, w components of a vec4 as a vec4
+func Vec4YZXW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[2], a[0], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YZYX set out to the y, z, y, x components of a vec4 as a vec4
+func Vec4YZYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[2], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YZYY set out to the y, z, y, y components of a vec4 as a vec4
+func Vec4YZYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[2], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YZYZ set out to the y, z, y, z components of a vec4 as a vec4
+func Vec4YZYZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[2], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YZYW set out to the y, z, y, w components of a vec4 as a vec4
+func Vec4YZYW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[2], a[1], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YZZX set out to the y, z, z, x components of a vec4 as a vec4
+func Vec4YZZX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[2], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YZZY set out to the y, z, z, y components of a vec4 as a vec4
+func Vec4YZZY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[2], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YZZZ set out to the y, z, z, z components of a vec4 as a vec4
+func Vec4YZZZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[2], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YZZW set out to the y, z, z, w components of a vec4 as a vec4
+func Vec4YZZW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[2], a[2], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YZWX set out to the y, z, w, x components of a vec4 as a vec4
+func Vec4YZWX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[2], a[3], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YZWY set out to the y, z, w, y components of a vec4 as a vec4
+func Vec4YZWY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[2], a[3], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YZWZ set out to the y, z, w, z components of a vec4 as a vec4
+func Vec4YZWZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[2], a[3], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YZWW set out to the y, z, w, w components of a vec4 as a vec4
+func Vec4YZWW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[2], a[3], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YWXX set out to the y, w, x, x components of a vec4 as a vec4
+func Vec4YWXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[3], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YWXY set out to the y, w, x, y components of a vec4 as a vec4
+func Vec4YWXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[3], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YWXZ set out to the y, w, x, z components of a vec4 as a vec4
+func Vec4YWXZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[3], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YWXW set out to the y, w, x, w components of a vec4 as a vec4
+func Vec4YWXW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[3], a[0], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YWYX set out to the y, w, y, x components of a vec4 as a vec4
+func Vec4YWYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[3], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YWYY set out to the y, w, y, y components of a vec4 as a vec4
+func Vec4YWYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[3], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YWYZ set out to the y, w, y, z components of a vec4 as a vec4
+func Vec4YWYZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[3], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YWYW set out to the y, w, y, w components of a vec4 as a vec4
+func Vec4YWYW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[3], a[1], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YWZX set out to the y, w, z, x components of a vec4 as a vec4
+func Vec4YWZX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[3], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YWZY set out to the y, w, z, y components of a vec4 as a vec4
+func Vec4YWZY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[3], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YWZZ set out to the y, w, z, z components of a vec4 as a vec4
+func Vec4YWZZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[3], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YWZW set out to the y, w, z, w components of a vec4 as a vec4
+func Vec4YWZW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[3], a[2], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YWWX set out to the y, w, w, x components of a vec4 as a vec4
+func Vec4YWWX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[3], a[3], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YWWY set out to the y, w, w, y components of a vec4 as a vec4
+func Vec4YWWY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[3], a[3], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YWWZ set out to the y, w, w, z components of a vec4 as a vec4
+func Vec4YWWZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[3], a[3], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4YWWW set out to the y, w, w, w components of a vec4 as a vec4
+func Vec4YWWW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[1], a[3], a[3], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZXXX set out to the z, x, x, x components of a vec4 as a vec4
+func Vec4ZXXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[0], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZXXY set out to the z, x, x, y components of a vec4 as a vec4
+func Vec4ZXXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[0], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZXXZ set out to the z, x, x, z components of a vec4 as a vec4
+func Vec4ZXXZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[0], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZXXW set out to the z, x, x, w components of a vec4 as a vec4
+func Vec4ZXXW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[0], a[0], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZXYX set out to the z, x, y, x components of a vec4 as a vec4
+func Vec4ZXYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[0], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZXYY set out to the z, x, y, y components of a vec4 as a vec4
+func Vec4ZXYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[0], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZXYZ set out to the z, x, y, z components of a vec4 as a vec4
+func Vec4ZXYZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[0], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZXYW set out to the z, x, y, w components of a vec4 as a vec4
+func Vec4ZXYW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[0], a[1], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZXZX set out to the z, x, z, x components of a vec4 as a vec4
+func Vec4ZXZX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[0], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZXZY set out to the z, x, z, y components of a vec4 as a vec4
+func Vec4ZXZY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[0], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZXZZ set out to the z, x, z, z components of a vec4 as a vec4
+func Vec4ZXZZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[0], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZXZW set out to the z, x, z, w components of a vec4 as a vec4
+func Vec4ZXZW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[0], a[2], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZXWX set out to the z, x, w, x components of a vec4 as a vec4
+func Vec4ZXWX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[0], a[3], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZXWY set out to the z, x, w, y components of a vec4 as a vec4
+func Vec4ZXWY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[0], a[3], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZXWZ set out to the z, x, w, z components of a vec4 as a vec4
+func Vec4ZXWZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[0], a[3], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZXWW set out to the z, x, w, w components of a vec4 as a vec4
+func Vec4ZXWW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[0], a[3], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZYXX set out to the z, y, x, x components of a vec4 as a vec4
+func Vec4ZYXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[1], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZYXY set out to the z, y, x, y components of a vec4 as a vec4
+func Vec4ZYXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[1], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZYXZ set out to the z, y, x, z components of a vec4 as a vec4
+func Vec4ZYXZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[1], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZYXW set out to the z, y, x, w components of a vec4 as a vec4
+func Vec4ZYXW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[1], a[0], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZYYX set out to the z, y, y, x components of a vec4 as a vec4
+func Vec4ZYYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[1], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZYYY set out to the z, y, y, y components of a vec4 as a vec4
+func Vec4ZYYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[1], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZYYZ set out to the z, y, y, z components of a vec4 as a vec4
+func Vec4ZYYZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[1], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZYYW set out to the z, y, y, w components of a vec4 as a vec4
+func Vec4ZYYW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[1], a[1], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZYZX set out to the z, y, z, x components of a vec4 as a vec4
+func Vec4ZYZX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[1], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZYZY set out to the z, y, z, y components of a vec4 as a vec4
+func Vec4ZYZY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[1], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZYZZ set out to the z, y, z, z components of a vec4 as a vec4
+func Vec4ZYZZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[1], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZYZW set out to the z, y, z, w components of a vec4 as a vec4
+func Vec4ZYZW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[1], a[2], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZYWX set out to the z, y, w, x components of a vec4 as a vec4
+func Vec4ZYWX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[1], a[3], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZYWY set out to the z, y, w, y components of a vec4 as a vec4
+func Vec4ZYWY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[1], a[3], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZYWZ set out to the z, y, w, z components of a vec4 as a vec4
+func Vec4ZYWZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[1], a[3], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZYWW set out to the z, y, w, w components of a vec4 as a vec4
+func Vec4ZYWW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[1], a[3], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZZXX set out to the z, z, x, x components of a vec4 as a vec4
+func Vec4ZZXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[2], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZZXY set out to the z, z, x, y components of a vec4 as a vec4
+func Vec4ZZXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[2], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZZXZ set out to the z, z, x, z components of a vec4 as a vec4
+func Vec4ZZXZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[2], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZZXW set out to the z, z, x, w components of a vec4 as a vec4
+func Vec4ZZXW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[2], a[0], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZZYX set out to the z, z, y, x components of a vec4 as a vec4
+func Vec4ZZYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[2], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZZYY set out to the z, z, y, y components of a vec4 as a vec4
+func Vec4ZZYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[2], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZZYZ set out to the z, z, y, z components of a vec4 as a vec4
+func Vec4ZZYZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[2], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZZYW set out to the z, z, y, w components of a vec4 as a vec4
+func Vec4ZZYW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[2], a[1], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZZZX set out to the z, z, z, x components of a vec4 as a vec4
+func Vec4ZZZX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[2], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZZZY set out to the z, z, z, y components of a vec4 as a vec4
+func Vec4ZZZY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[2], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZZZZ set out to the z, z, z, z components of a vec4 as a vec4
+func Vec4ZZZZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[2], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZZZW set out to the z, z, z, w components of a vec4 as a vec4
+func Vec4ZZZW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[2], a[2], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZZWX set out to the z, z, w, x components of a vec4 as a vec4
+func Vec4ZZWX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[2], a[3], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZZWY set out to the z, z, w, y components of a vec4 as a vec4
+func Vec4ZZWY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[2], a[3], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZZWZ set out to the z, z, w, z components of a vec4 as a vec4
+func Vec4ZZWZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[2], a[3], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZZWW set out to the z, z, w, w components of a vec4 as a vec4
+func Vec4ZZWW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[2], a[3], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZWXX set out to the z, w, x, x components of a vec4 as a vec4
+func Vec4ZWXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[3], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZWXY set out to the z, w, x, y components of a vec4 as a vec4
+func Vec4ZWXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[3], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZWXZ set out to the z, w, x, z components of a vec4 as a vec4
+func Vec4ZWXZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[3], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZWXW set out to the z, w, x, w components of a vec4 as a vec4
+func Vec4ZWXW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[3], a[0], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZWYX set out to the z, w, y, x components of a vec4 as a vec4
+func Vec4ZWYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[3], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZWYY set out to the z, w, y, y components of a vec4 as a vec4
+func Vec4ZWYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[3], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZWYZ set out to the z, w, y, z components of a vec4 as a vec4
+func Vec4ZWYZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[3], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZWYW set out to the z, w, y, w components of a vec4 as a vec4
+func Vec4ZWYW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[3], a[1], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZWZX set out to the z, w, z, x components of a vec4 as a vec4
+func Vec4ZWZX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[3], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZWZY set out to the z, w, z, y components of a vec4 as a vec4
+func Vec4ZWZY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[3], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZWZZ set out to the z, w, z, z components of a vec4 as a vec4
+func Vec4ZWZZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[3], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZWZW set out to the z, w, z, w components of a vec4 as a vec4
+func Vec4ZWZW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[3], a[2], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZWWX set out to the z, w, w, x components of a vec4 as a vec4
+func Vec4ZWWX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[3], a[3], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZWWY set out to the z, w, w, y components of a vec4 as a vec4
+func Vec4ZWWY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[3], a[3], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZWWZ set out to the z, w, w, z components of a vec4 as a vec4
+func Vec4ZWWZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[3], a[3], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4ZWWW set out to the z, w, w, w components of a vec4 as a vec4
+func Vec4ZWWW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[2], a[3], a[3], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WXXX set out to the w, x, x, x components of a vec4 as a vec4
+func Vec4WXXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[0], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WXXY set out to the w, x, x, y components of a vec4 as a vec4
+func Vec4WXXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[0], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WXXZ set out to the w, x, x, z components of a vec4 as a vec4
+func Vec4WXXZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[0], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WXXW set out to the w, x, x, w components of a vec4 as a vec4
+func Vec4WXXW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[0], a[0], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WXYX set out to the w, x, y, x components of a vec4 as a vec4
+func Vec4WXYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[0], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WXYY set out to the w, x, y, y components of a vec4 as a vec4
+func Vec4WXYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[0], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WXYZ set out to the w, x, y, z components of a vec4 as a vec4
+func Vec4WXYZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[0], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WXYW set out to the w, x, y, w components of a vec4 as a vec4
+func Vec4WXYW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[0], a[1], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WXZX set out to the w, x, z, x components of a vec4 as a vec4
+func Vec4WXZX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[0], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WXZY set out to the w, x, z, y components of a vec4 as a vec4
+func Vec4WXZY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[0], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WXZZ set out to the w, x, z, z components of a vec4 as a vec4
+func Vec4WXZZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[0], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WXZW set out to the w, x, z, w components of a vec4 as a vec4
+func Vec4WXZW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[0], a[2], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WXWX set out to the w, x, w, x components of a vec4 as a vec4
+func Vec4WXWX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[0], a[3], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WXWY set out to the w, x, w, y components of a vec4 as a vec4
+func Vec4WXWY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[0], a[3], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WXWZ set out to the w, x, w, z components of a vec4 as a vec4
+func Vec4WXWZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[0], a[3], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WXWW set out to the w, x, w, w components of a vec4 as a vec4
+func Vec4WXWW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[0], a[3], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WYXX set out to the w, y, x, x components of a vec4 as a vec4
+func Vec4WYXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[1], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WYXY set out to the w, y, x, y components of a vec4 as a vec4
+func Vec4WYXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[1], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WYXZ set out to the w, y, x, z components of a vec4 as a vec4
+func Vec4WYXZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[1], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WYXW set out to the w, y, x, w components of a vec4 as a vec4
+func Vec4WYXW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[1], a[0], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WYYX set out to the w, y, y, x components of a vec4 as a vec4
+func Vec4WYYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[1], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WYYY set out to the w, y, y, y components of a vec4 as a vec4
+func Vec4WYYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[1], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WYYZ set out to the w, y, y, z components of a vec4 as a vec4
+func Vec4WYYZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[1], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WYYW set out to the w, y, y, w components of a vec4 as a vec4
+func Vec4WYYW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[1], a[1], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WYZX set out to the w, y, z, x components of a vec4 as a vec4
+func Vec4WYZX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[1], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WYZY set out to the w, y, z, y components of a vec4 as a vec4
+func Vec4WYZY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[1], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WYZZ set out to the w, y, z, z components of a vec4 as a vec4
+func Vec4WYZZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[1], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WYZW set out to the w, y, z, w components of a vec4 as a vec4
+func Vec4WYZW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[1], a[2], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WYWX set out to the w, y, w, x components of a vec4 as a vec4
+func Vec4WYWX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[1], a[3], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WYWY set out to the w, y, w, y components of a vec4 as a vec4
+func Vec4WYWY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[1], a[3], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WYWZ set out to the w, y, w, z components of a vec4 as a vec4
+func Vec4WYWZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[1], a[3], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WYWW set out to the w, y, w, w components of a vec4 as a vec4
+func Vec4WYWW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[1], a[3], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WZXX set out to the w, z, x, x components of a vec4 as a vec4
+func Vec4WZXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[2], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WZXY set out to the w, z, x, y components of a vec4 as a vec4
+func Vec4WZXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[2], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WZXZ set out to the w, z, x, z components of a vec4 as a vec4
+func Vec4WZXZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[2], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WZXW set out to the w, z, x, w components of a vec4 as a vec4
+func Vec4WZXW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[2], a[0], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WZYX set out to the w, z, y, x components of a vec4 as a vec4
+func Vec4WZYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[2], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WZYY set out to the w, z, y, y components of a vec4 as a vec4
+func Vec4WZYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[2], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WZYZ set out to the w, z, y, z components of a vec4 as a vec4
+func Vec4WZYZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[2], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WZYW set out to the w, z, y, w components of a vec4 as a vec4
+func Vec4WZYW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[2], a[1], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WZZX set out to the w, z, z, x components of a vec4 as a vec4
+func Vec4WZZX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[2], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WZZY set out to the w, z, z, y components of a vec4 as a vec4
+func Vec4WZZY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[2], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WZZZ set out to the w, z, z, z components of a vec4 as a vec4
+func Vec4WZZZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[2], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WZZW set out to the w, z, z, w components of a vec4 as a vec4
+func Vec4WZZW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[2], a[2], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WZWX set out to the w, z, w, x components of a vec4 as a vec4
+func Vec4WZWX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[2], a[3], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WZWY set out to the w, z, w, y components of a vec4 as a vec4
+func Vec4WZWY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[2], a[3], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WZWZ set out to the w, z, w, z components of a vec4 as a vec4
+func Vec4WZWZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[2], a[3], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WZWW set out to the w, z, w, w components of a vec4 as a vec4
+func Vec4WZWW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[2], a[3], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WWXX set out to the w, w, x, x components of a vec4 as a vec4
+func Vec4WWXX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[3], a[0], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WWXY set out to the w, w, x, y components of a vec4 as a vec4
+func Vec4WWXY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[3], a[0], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WWXZ set out to the w, w, x, z components of a vec4 as a vec4
+func Vec4WWXZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[3], a[0], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WWXW set out to the w, w, x, w components of a vec4 as a vec4
+func Vec4WWXW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[3], a[0], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WWYX set out to the w, w, y, x components of a vec4 as a vec4
+func Vec4WWYX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[3], a[1], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WWYY set out to the w, w, y, y components of a vec4 as a vec4
+func Vec4WWYY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[3], a[1], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WWYZ set out to the w, w, y, z components of a vec4 as a vec4
+func Vec4WWYZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[3], a[1], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WWYW set out to the w, w, y, w components of a vec4 as a vec4
+func Vec4WWYW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[3], a[1], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WWZX set out to the w, w, z, x components of a vec4 as a vec4
+func Vec4WWZX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[3], a[2], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WWZY set out to the w, w, z, y components of a vec4 as a vec4
+func Vec4WWZY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[3], a[2], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WWZZ set out to the w, w, z, z components of a vec4 as a vec4
+func Vec4WWZZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[3], a[2], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WWZW set out to the w, w, z, w components of a vec4 as a vec4
+func Vec4WWZW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[3], a[2], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WWWX set out to the w, w, w, x components of a vec4 as a vec4
+func Vec4WWWX(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[3], a[3], a[0]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WWWY set out to the w, w, w, y components of a vec4 as a vec4
+func Vec4WWWY(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[3], a[3], a[1]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WWWZ set out to the w, w, w, z components of a vec4 as a vec4
+func Vec4WWWZ(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[3], a[3], a[2]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
+
+// Vec4WWWW set out to the w, w, w, w components of a vec4 as a vec4
+func Vec4WWWW(out, a []float64) []float64 {
+	v0, v1, v2, v3 := a[3], a[3], a[3], a[3]
+	out[0] = v0
+	out[1] = v1
+	out[2] = v2
+	out[3] = v3
+	return out
+}
@@ -0,0 +1,470 @@
+package glmatrix
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// The values are encoded as text in the format of the Str functions, e.g.
+// "vec3(1, 2, 3)", and as JSON arrays, e.g. [1, 2, 3]. JSON objects with
+// named fields are also accepted when decoding: x, y, z and w for vectors and
+// quaternions, x1 to w2 for dual quaternions, a, b, c, d, tx and ty for mat2d
+// and mCR for the element of column C and row R of the other matrices,
+// e.g. {"m00": 1, "m01": 0, ...} for a mat2. Encoding always produces arrays.
+
+var vec2Fields = []string{"x", "y"}
+var vec3Fields = []string{"x", "y", "z"}
+var vec4Fields = []string{"x", "y", "z", "w"}
+var mat2Fields = []string{"m00", "m01", "m10", "m11"}
+var mat2dFields = []string{"a", "b", "c", "d", "tx", "ty"}
+var mat3Fields = []string{"m00", "m01", "m02", "m10", "m11", "m12", "m20", "m21", "m22"}
+var mat4Fields = []string{"m00", "m01", "m02", "m03", "m10", "m11", "m12", "m13", "m20", "m21", "m22", "m23", "m30", "m31", "m32", "m33"}
+var quatFields = []string{"x", "y", "z", "w"}
+var quat2Fields = []string{"x1", "y1", "z1", "w1", "x2", "y2", "z2", "w2"}
+var mat2x3Fields = []string{"m00", "m10", "m20", "m01", "m11", "m21"}
+var mat3x4Fields = []string{"m00", "m10", "m20", "m30", "m01", "m11", "m21", "m31", "m02", "m12", "m22", "m32"}
+var mat4x3Fields = []string{"m00", "m01", "m02", "m10", "m11", "m12", "m20", "m21", "m22", "m30", "m31", "m32"}
+
+// Vec2Parse parses a vec2 from the output of Vec2Str
+func Vec2Parse(s string) ([]float64, error) {
+	return parseStr(s, "vec2", 2)
+}
+
+// Vec3Parse parses a vec3 from the output of Vec3Str
+func Vec3Parse(s string) ([]float64, error) {
+	return parseStr(s, "vec3", 3)
+}
+
+// Vec4Parse parses a vec4 from the output of Vec4Str
+func Vec4Parse(s string) ([]float64, error) {
+	return parseStr(s, "vec4", 4)
+}
+
+// Mat2Parse parses a mat2 from the output of Mat2Str
+func Mat2Parse(s string) ([]float64, error) {
+	return parseStr(s, "mat2", 4)
+}
+
+// Mat2dParse parses a mat2d from the output of Mat2dStr
+func Mat2dParse(s string) ([]float64, error) {
+	return parseStr(s, "mat2d", 6)
+}
+
+// Mat3Parse parses a mat3 from the output of Mat3Str
+func Mat3Parse(s string) ([]float64, error) {
+	return parseStr(s, "mat3", 9)
+}
+
+// Mat4Parse parses a mat4 from the output of Mat4Str
+func Mat4Parse(s string) ([]float64, error) {
+	return parseStr(s, "mat4", 16)
+}
+
+// QuatParse parses a quat from the output of QuatStr
+func QuatParse(s string) ([]float64, error) {
+	return parseStr(s, "quat", 4)
+}
+
+// Quat2Parse parses a quat2 from the output of Quat2Str
+func Quat2Parse(s string) ([]float64, error) {
+	return parseStr(s, "quat2", 8)
+}
+
+// Mat2x3Parse parses a mat2x3 from the output of Mat2x3Str
+func Mat2x3Parse(s string) ([]float64, error) {
+	return parseStr(s, "mat2x3", 6)
+}
+
+// Mat3x4Parse parses a mat3x4 from the output of Mat3x4Str
+func Mat3x4Parse(s string) ([]float64, error) {
+	return parseStr(s, "mat3x4", 12)
+}
+
+// Mat4x3Parse parses a mat4x3 from the output of Mat4x3Str
+func Mat4x3Parse(s string) ([]float64, error) {
+	return parseStr(s, "mat4x3", 12)
+}
+
+// MarshalText encodes a vec2 in the format of Vec2Str
+func (a Vec2) MarshalText() ([]byte, error) {
+	return marshalText(a, vec2Fields, "vec2")
+}
+
+// UnmarshalText decodes a vec2 in the format of Vec2Str
+func (a *Vec2) UnmarshalText(data []byte) error {
+	return unmarshalText((*[]float64)(a), data, vec2Fields, "vec2")
+}
+
+// MarshalJSON encodes a vec2 as a JSON array
+func (a Vec2) MarshalJSON() ([]byte, error) {
+	return marshalJSON(a, vec2Fields, "vec2")
+}
+
+// UnmarshalJSON decodes a vec2 from a JSON array or object
+func (a *Vec2) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON((*[]float64)(a), data, vec2Fields, "vec2")
+}
+
+// MarshalText encodes a vec3 in the format of Vec3Str
+func (a Vec3) MarshalText() ([]byte, error) {
+	return marshalText(a, vec3Fields, "vec3")
+}
+
+// UnmarshalText decodes a vec3 in the format of Vec3Str
+func (a *Vec3) UnmarshalText(data []byte) error {
+	return unmarshalText((*[]float64)(a), data, vec3Fields, "vec3")
+}
+
+// MarshalJSON encodes a vec3 as a JSON array
+func (a Vec3) MarshalJSON() ([]byte, error) {
+	return marshalJSON(a, vec3Fields, "vec3")
+}
+
+// UnmarshalJSON decodes a vec3 from a JSON array or object
+func (a *Vec3) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON((*[]float64)(a), data, vec3Fields, "vec3")
+}
+
+// MarshalText encodes a vec4 in the format of Vec4Str
+func (a Vec4) MarshalText() ([]byte, error) {
+	return marshalText(a, vec4Fields, "vec4")
+}
+
+// UnmarshalText decodes a vec4 in the format of Vec4Str
+func (a *Vec4) UnmarshalText(data []byte) error {
+	return unmarshalText((*[]float64)(a), data, vec4Fields, "vec4")
+}
+
+// MarshalJSON encodes a vec4 as a JSON array
+func (a Vec4) MarshalJSON() ([]byte, error) {
+	return marshalJSON(a, vec4Fields, "vec4")
+}
+
+// UnmarshalJSON decodes a vec4 from a JSON array or object
+func (a *Vec4) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON((*[]float64)(a), data, vec4Fields, "vec4")
+}
+
+// MarshalText encodes a mat2 in the format of Mat2Str
+func (a Mat2) MarshalText() ([]byte, error) {
+	return marshalText(a, mat2Fields, "mat2")
+}
+
+// UnmarshalText decodes a mat2 in the format of Mat2Str
+func (a *Mat2) UnmarshalText(data []byte) error {
+	return unmarshalText((*[]float64)(a), data, mat2Fields, "mat2")
+}
+
+// MarshalJSON encodes a mat2 as a JSON array
+func (a Mat2) MarshalJSON() ([]byte, error) {
+	return marshalJSON(a, mat2Fields, "mat2")
+}
+
+// UnmarshalJSON decodes a mat2 from a JSON array or object
+func (a *Mat2) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON((*[]float64)(a), data, mat2Fields, "mat2")
+}
+
+// MarshalText encodes a mat2d in the format of Mat2dStr
+func (a Mat2d) MarshalText() ([]byte, error) {
+	return marshalText(a, mat2dFields, "mat2d")
+}
+
+// UnmarshalText decodes a mat2d in the format of Mat2dStr
+func (a *Mat2d) UnmarshalText(data []byte) error {
+	return unmarshalText((*[]float64)(a), data, mat2dFields, "mat2d")
+}
+
+// MarshalJSON encodes a mat2d as a JSON array
+func (a Mat2d) MarshalJSON() ([]byte, error) {
+	return marshalJSON(a, mat2dFields, "mat2d")
+}
+
+// UnmarshalJSON decodes a mat2d from a JSON array or object
+func (a *Mat2d) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON((*[]float64)(a), data, mat2dFields, "mat2d")
+}
+
+// MarshalText encodes a mat3 in the format of Mat3Str
+func (a Mat3) MarshalText() ([]byte, error) {
+	return marshalText(a, mat3Fields, "mat3")
+}
+
+// UnmarshalText decodes a mat3 in the format of Mat3Str
+func (a *Mat3) UnmarshalText(data []byte) error {
+	return unmarshalText((*[]float64)(a), data, mat3Fields, "mat3")
+}
+
+// MarshalJSON encodes a mat3 as a JSON array
+func (a Mat3) MarshalJSON() ([]byte, error) {
+	return marshalJSON(a, mat3Fields, "mat3")
+}
+
+// UnmarshalJSON decodes a mat3 from a JSON array or object
+func (a *Mat3) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON((*[]float64)(a), data, mat3Fields, "mat3")
+}
+
+// MarshalText encodes a mat4 in the format of Mat4Str
+func (a Mat4) MarshalText() ([]byte, error) {
+	return marshalText(a, mat4Fields, "mat4")
+}
+
+// UnmarshalText decodes a mat4 in the format of Mat4Str
+func (a *Mat4) UnmarshalText(data []byte) error {
+	return unmarshalText((*[]float64)(a), data, mat4Fields, "mat4")
+}
+
+// MarshalJSON encodes a mat4 as a JSON array
+func (a Mat4) MarshalJSON() ([]byte, error) {
+	return marshalJSON(a, mat4Fields, "mat4")
+}
+
+// UnmarshalJSON decodes a mat4 from a JSON array or object
+func (a *Mat4) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON((*[]float64)(a), data, mat4Fields, "mat4")
+}
+
+// MarshalText encodes a quat in the format of QuatStr
+func (a Quat) MarshalText() ([]byte, error) {
+	return marshalText(a, quatFields, "quat")
+}
+
+// UnmarshalText decodes a quat in the format of QuatStr
+func (a *Quat) UnmarshalText(data []byte) error {
+	return unmarshalText((*[]float64)(a), data, quatFields, "quat")
+}
+
+// MarshalJSON encodes a quat as a JSON array
+func (a Quat) MarshalJSON() ([]byte, error) {
+	return marshalJSON(a, quatFields, "quat")
+}
+
+// UnmarshalJSON decodes a quat from a JSON array or object
+func (a *Quat) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON((*[]float64)(a), data, quatFields, "quat")
+}
+
+// MarshalText encodes a quat2 in the format of Quat2Str
+func (a Quat2) MarshalText() ([]byte, error) {
+	return marshalText(a, quat2Fields, "quat2")
+}
+
+// UnmarshalText decodes a quat2 in the format of Quat2Str
+func (a *Quat2) UnmarshalText(data []byte) error {
+	return unmarshalText((*[]float64)(a), data, quat2Fields, "quat2")
+}
+
+// MarshalJSON encodes a quat2 as a JSON array
+func (a Quat2) MarshalJSON() ([]byte, error) {
+	return marshalJSON(a, quat2Fields, "quat2")
+}
+
+// UnmarshalJSON decodes a quat2 from a JSON array or object
+func (a *Quat2) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON((*[]float64)(a), data, quat2Fields, "quat2")
+}
+
+// MarshalText encodes a mat2x3 in the format of Mat2x3Str
+func (a Mat2x3) MarshalText() ([]byte, error) {
+	return marshalText(a, mat2x3Fields, "mat2x3")
+}
+
+// UnmarshalText decodes a mat2x3 in the format of Mat2x3Str
+func (a *Mat2x3) UnmarshalText(data []byte) error {
+	return unmarshalText((*[]float64)(a), data, mat2x3Fields, "mat2x3")
+}
+
+// MarshalJSON encodes a mat2x3 as a JSON array
+func (a Mat2x3) MarshalJSON() ([]byte, error) {
+	return marshalJSON(a, mat2x3Fields, "mat2x3")
+}
+
+// UnmarshalJSON decodes a mat2x3 from a JSON array or object
+func (a *Mat2x3) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON((*[]float64)(a), data, mat2x3Fields, "mat2x3")
+}
+
+// MarshalText encodes a mat3x4 in the format of Mat3x4Str
+func (a Mat3x4) MarshalText() ([]byte, error) {
+	return marshalText(a, mat3x4Fields, "mat3x4")
+}
+
+// UnmarshalText decodes a mat3x4 in the format of Mat3x4Str
+func (a *Mat3x4) UnmarshalText(data []byte) error {
+	return unmarshalText((*[]float64)(a), data, mat3x4Fields, "mat3x4")
+}
+
+// MarshalJSON encodes a mat3x4 as a JSON array
+func (a Mat3x4) MarshalJSON() ([]byte, error) {
+	return marshalJSON(a, mat3x4Fields, "mat3x4")
+}
+
+// UnmarshalJSON decodes a mat3x4 from a JSON array or object
+func (a *Mat3x4) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON((*[]float64)(a), data, mat3x4Fields, "mat3x4")
+}
+
+// MarshalText encodes a mat4x3 in the format of Mat4x3Str
+func (a Mat4x3) MarshalText() ([]byte, error) {
+	return marshalText(a, mat4x3Fields, "mat4x3")
+}
+
+// UnmarshalText decodes a mat4x3 in the format of Mat4x3Str
+func (a *Mat4x3) UnmarshalText(data []byte) error {
+	return unmarshalText((*[]float64)(a), data, mat4x3Fields, "mat4x3")
+}
+
+// MarshalJSON encodes a mat4x3 as a JSON array
+func (a Mat4x3) MarshalJSON() ([]byte, error) {
+	return marshalJSON(a, mat4x3Fields, "mat4x3")
+}
+
+// UnmarshalJSON decodes a mat4x3 from a JSON array or object
+func (a *Mat4x3) UnmarshalJSON(data []byte) error {
+	return unmarshalJSON((*[]float64)(a), data, mat4x3Fields, "mat4x3")
+}
+
+// IVec2Parse parses an IVec2 from the output of IVec2Str
+func IVec2Parse(s string) ([]int, error) {
+	return parseIntStr(s, "ivec2", 2)
+}
+
+// IVec3Parse parses an IVec3 from the output of IVec3Str
+func IVec3Parse(s string) ([]int, error) {
+	return parseIntStr(s, "ivec3", 3)
+}
+
+// splitStr splits the output of a Str function into its values
+func splitStr(s, name string, n int) ([]string, error) {
+	t := strings.TrimSpace(s)
+	if !strings.HasPrefix(t, name+"(") || !strings.HasSuffix(t, ")") {
+		return nil, fmt.Errorf("glmatrix: invalid %v %q: expected %v(...)", name, s, name)
+	}
+	values := strings.Split(t[len(name)+1:len(t)-1], ",")
+	if len(values) != n {
+		return nil, fmt.Errorf("glmatrix: invalid %v %q: expected %v values but got %v", name, s, n, len(values))
+	}
+	for i := range values {
+		values[i] = strings.TrimSpace(values[i])
+	}
+	return values, nil
+}
+
+func parseStr(s, name string, n int) ([]float64, error) {
+	values, err := splitStr(s, name, n)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]float64, n)
+	for i, v := range values {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return nil, fmt.Errorf("glmatrix: invalid %v %q: value %v is not a number: %q", name, s, i, v)
+		}
+		out[i] = f
+	}
+	return out, nil
+}
+
+func parseIntStr(s, name string, n int) ([]int, error) {
+	values, err := splitStr(s, name, n)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]int, n)
+	for i, v := range values {
+		d, err := strconv.Atoi(v)
+		if err != nil {
+			return nil, fmt.Errorf("glmatrix: invalid %v %q: value %v is not an integer: %q", name, s, i, v)
+		}
+		out[i] = d
+	}
+	return out, nil
+}
+
+func checkLength(a []float64, n int, name string) error {
+	if len(a) != n {
+		return fmt.Errorf("glmatrix: %v must have %v values but has %v", name, n, len(a))
+	}
+	return nil
+}
+
+func marshalText(a []float64, fields []string, name string) ([]byte, error) {
+	if err := checkLength(a, len(fields), name); err != nil {
+		return nil, err
+	}
+	values := make([]string, len(a))
+	for i, v := range a {
+		values[i] = fmt.Sprint(v)
+	}
+	return []byte(name + "(" + strings.Join(values, ", ") + ")"), nil
+}
+
+func unmarshalText(a *[]float64, data []byte, fields []string, name string) error {
+	v, err := parseStr(string(data), name, len(fields))
+	if err != nil {
+		return err
+	}
+	*a = v
+	return nil
+}
+
+func marshalJSON(a []float64, fields []string, name string) ([]byte, error) {
+	if err := checkLength(a, len(fields), name); err != nil {
+		return nil, err
+	}
+	return json.Marshal([]float64(a))
+}
+
+func unmarshalJSON(a *[]float64, data []byte, fields []string, name string) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	n := len(fields)
+	if len(data) > 0 && data[0] == '{' {
+		var object map[string]float64
+		if err := json.Unmarshal(data, &object); err != nil {
+			return fmt.Errorf("glmatrix: invalid %v object: %w", name, err)
+		}
+		v := make([]float64, n)
+		for i, field := range fields {
+			f, ok := object[field]
+			if !ok {
+				return fmt.Errorf("glmatrix: invalid %v object: missing field %q", name, field)
+			}
+			v[i] = f
+		}
+		if len(object) != n {
+			for key := range object {
+				if !containsString(fields, key) {
+					return fmt.Errorf("glmatrix: invalid %v object: unknown field %q", name, key)
+				}
+			}
+		}
+		*a = v
+		return nil
+	}
+	var v []float64
+	if err := json.Unmarshal(data, &v); err != nil {
+		return fmt.Errorf("glmatrix: invalid %v: expected an array or an object: %w", name, err)
+	}
+	if len(v) != n {
+		return fmt.Errorf("glmatrix: invalid %v: expected %v values but got %v", name, n, len(v))
+	}
+	*a = v
+	return nil
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
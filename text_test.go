@@ -0,0 +1,184 @@
+package glmatrix
+
+import (
+	"encoding"
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+)
+
+var _ encoding.TextMarshaler = Quat{}
+var _ json.Unmarshaler = (*Vec3)(nil)
+
+func TestParseStr(t *testing.T) {
+	actual, err := Vec3Parse(Vec3Str([]float64{1.5, -2, 3e-10}))
+	if err != nil || !testSlice(actual, []float64{1.5, -2, 3e-10}) {
+		t.Errorf("vec3 parse: %v %v", actual, err)
+	}
+	m := Mat4FromRotationTranslation(Mat4Create(), QuatNormalize(QuatCreate(), []float64{1, 2, 3, 4}), vec3A)
+	actual, err = Mat4Parse(Mat4Str(m))
+	if err != nil || !Mat4ExactEquals(actual, m) {
+		t.Errorf("mat4 parse: %v %v", actual, err)
+	}
+	actual, err = QuatParse("  quat(0,0 , 0,1) ")
+	if err != nil || !testSlice(actual, QuatCreate()) {
+		t.Errorf("quat parse: %v %v", actual, err)
+	}
+	actual, err = Vec2Parse(Vec2Str([]float64{math.Inf(1), math.NaN()}))
+	if err != nil || !math.IsInf(actual[0], 1) || !math.IsNaN(actual[1]) {
+		t.Errorf("vec2 parse: %v %v", actual, err)
+	}
+	for _, s := range []string{Mat2dStr(Mat2dCreate()), Mat3Str(Mat3Create()), Quat2Str(Quat2Create()),
+		Mat2x3Str(Mat2x3Create()), Mat3x4Str(Mat3x4Create()), Mat4x3Str(Mat4x3Create()),
+		Mat2Str(Mat2Create()), Vec4Str(vec4A)} {
+		name := s[:strings.Index(s, "(")]
+		var err error
+		switch name {
+		case "mat2d":
+			_, err = Mat2dParse(s)
+		case "mat3":
+			_, err = Mat3Parse(s)
+		case "quat2":
+			_, err = Quat2Parse(s)
+		case "mat2x3":
+			_, err = Mat2x3Parse(s)
+		case "mat3x4":
+			_, err = Mat3x4Parse(s)
+		case "mat4x3":
+			_, err = Mat4x3Parse(s)
+		case "mat2":
+			_, err = Mat2Parse(s)
+		case "vec4":
+			_, err = Vec4Parse(s)
+		}
+		if err != nil {
+			t.Errorf("parse %v: %v", s, err)
+		}
+	}
+}
+
+func TestParseStrErrors(t *testing.T) {
+	cases := map[string]string{
+		"vec2(1, 2)":       "expected vec3(...)",
+		"vec3(1, 2, 3":     "expected vec3(...)",
+		"vec3(1, two, 3)":  "value 1 is not a number",
+		"vec3(1, 2, 3, 4)": "expected 3 values but got 4",
+	}
+	for s, expect := range cases {
+		_, err := Vec3Parse(s)
+		if err == nil || !strings.Contains(err.Error(), expect) {
+			t.Errorf("parse %q: %v", s, err)
+		}
+	}
+}
+
+func TestIVecParse(t *testing.T) {
+	actual, err := IVec3Parse(IVec3Str([]int{1, -2, 3}))
+	if err != nil || !testIntSlice(actual, []int{1, -2, 3}) {
+		t.Errorf("ivec3 parse: %v %v", actual, err)
+	}
+	if _, err := IVec2Parse("ivec2(1.5, 2)"); err == nil {
+		t.Errorf("ivec2 parse float: no error")
+	}
+}
+
+func TestTextMarshal(t *testing.T) {
+	data, err := Quat(QuatCreate()).MarshalText()
+	if err != nil || string(data) != "quat(0, 0, 0, 1)" {
+		t.Errorf("marshal text: %s %v", data, err)
+	}
+	var q Quat
+	if err := q.UnmarshalText(data); err != nil || !testSlice(q, QuatCreate()) {
+		t.Errorf("unmarshal text: %v %v", q, err)
+	}
+	if err := q.UnmarshalText([]byte("vec4(0, 0, 0, 1)")); err == nil {
+		t.Errorf("unmarshal text wrong type: no error")
+	}
+}
+
+type testTransform struct {
+	Position Vec3 `json:"position"`
+	Rotation Quat `json:"rotation"`
+	Matrix   Mat2 `json:"matrix"`
+}
+
+func TestJSONMarshal(t *testing.T) {
+	data, err := json.Marshal(testTransform{Vec3(vec3A), Quat(QuatCreate()), Mat2(Mat2Create())})
+	expect := `{"position":[1,2,3],"rotation":[0,0,0,1],"matrix":[1,0,0,1]}`
+	if err != nil || string(data) != expect {
+		t.Errorf("marshal json: %s %v", data, err)
+	}
+	var actual testTransform
+	if err := json.Unmarshal(data, &actual); err != nil ||
+		!testSlice(actual.Position, vec3A) || !testSlice(actual.Rotation, QuatCreate()) || !testSlice(actual.Matrix, Mat2Create()) {
+		t.Errorf("unmarshal json: %v %v", actual, err)
+	}
+	if _, err := json.Marshal(Vec3(vec4A)); err == nil {
+		t.Errorf("marshal json wrong length: no error")
+	}
+}
+
+func TestJSONUnmarshalObject(t *testing.T) {
+	var actual testTransform
+	data := `{"position": {"x": 1, "y": 2, "z": 3}, "rotation": {"w": 1, "x": 0, "y": 0, "z": 0},
+		"matrix": {"m00": 1, "m01": 2, "m10": 3, "m11": 4}}`
+	if err := json.Unmarshal([]byte(data), &actual); err != nil ||
+		!testSlice(actual.Position, vec3A) || !testSlice(actual.Rotation, QuatCreate()) || !testSlice(actual.Matrix, []float64{1, 2, 3, 4}) {
+		t.Errorf("unmarshal json object: %v %v", actual, err)
+	}
+	var m Mat2d
+	if err := json.Unmarshal([]byte(`{"a": 1, "b": 2, "c": 3, "d": 4, "tx": 5, "ty": 6}`), &m); err != nil ||
+		!testSlice(m, []float64{1, 2, 3, 4, 5, 6}) {
+		t.Errorf("unmarshal json mat2d: %v %v", m, err)
+	}
+}
+
+func TestJSONRoundTripObjectMat2x3(t *testing.T) {
+	// a mat2x3 is row-major, so m10 is the second value of the first row
+	data := `{"m00": 1, "m10": 2, "m20": 3, "m01": 4, "m11": 5, "m21": 6}`
+	var m Mat2x3
+	if err := json.Unmarshal([]byte(data), &m); err != nil || !testSlice(m, []float64{1, 2, 3, 4, 5, 6}) {
+		t.Fatalf("unmarshal json mat2x3 object: %v %v", m, err)
+	}
+	encoded, err := json.Marshal(m)
+	var actual Mat2x3
+	if err != nil || json.Unmarshal(encoded, &actual) != nil || !testSlice(actual, m) {
+		t.Errorf("round trip json mat2x3: %s %v", encoded, err)
+	}
+}
+
+func TestJSONRoundTripObjectMat3x4(t *testing.T) {
+	data := `{"m00": 1, "m10": 2, "m20": 3, "m30": 4, "m01": 5, "m11": 6, "m21": 7, "m31": 8,
+		"m02": 9, "m12": 10, "m22": 11, "m32": 12}`
+	var m Mat3x4
+	if err := json.Unmarshal([]byte(data), &m); err != nil || !testSlice(m, []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}) {
+		t.Fatalf("unmarshal json mat3x4 object: %v %v", m, err)
+	}
+	encoded, err := json.Marshal(m)
+	var actual Mat3x4
+	if err != nil || json.Unmarshal(encoded, &actual) != nil || !testSlice(actual, m) {
+		t.Errorf("round trip json mat3x4: %s %v", encoded, err)
+	}
+}
+
+func TestJSONUnmarshalErrors(t *testing.T) {
+	cases := map[string]string{
+		`[1, 2]`:                           "expected 3 values but got 2",
+		`{"x": 1, "y": 2}`:                 `missing field "z"`,
+		`{"x": 1, "y": 2, "z": 3, "q": 4}`: `unknown field "q"`,
+		`{"x": "1", "y": 2, "z": 3}`:       "invalid vec3 object",
+		`"vec3(1, 2, 3)"`:                  "expected an array or an object",
+	}
+	for data, expect := range cases {
+		var v Vec3
+		err := json.Unmarshal([]byte(data), &v)
+		if err == nil || !strings.Contains(err.Error(), expect) {
+			t.Errorf("unmarshal %v: %v", data, err)
+		}
+	}
+	v := Vec3(Vec3Clone(vec3A))
+	if err := json.Unmarshal([]byte("null"), &v); err != nil || !testSlice(v, vec3A) {
+		t.Errorf("unmarshal null: %v %v", v, err)
+	}
+}
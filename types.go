@@ -0,0 +1,43 @@
+package glmatrix
+
+// The named types below are interchangeable with the []float64 values taken
+// by the functions of this package, e.g. Vec3Add(v, v, w) accepts v of type Vec3.
+// They exist to attach the standard encoding interfaces to the values.
+// MarshalJSON always encodes them as JSON arrays. The JSON objects with named
+// fields described in text.go are only accepted by UnmarshalJSON.
+
+// Vec2 is a 2 component vector
+type Vec2 []float64
+
+// Vec3 is a 3 component vector
+type Vec3 []float64
+
+// Vec4 is a 4 component vector
+type Vec4 []float64
+
+// Mat2 is a 2x2 matrix of 4 values in column-major order
+type Mat2 []float64
+
+// Mat2d is a 2D affine matrix of 6 values, see Mat2dCreate
+type Mat2d []float64
+
+// Mat3 is a 3x3 matrix of 9 values in column-major order
+type Mat3 []float64
+
+// Mat4 is a 4x4 matrix of 16 values in column-major order
+type Mat4 []float64
+
+// Quat is a quaternion of 4 values [x, y, z, w]
+type Quat []float64
+
+// Quat2 is a dual quaternion of 8 values
+type Quat2 []float64
+
+// Mat2x3 is a 2D affine matrix of 6 values in row-major order
+type Mat2x3 []float64
+
+// Mat3x4 is a 3D affine matrix of 12 values in row-major order
+type Mat3x4 []float64
+
+// Mat4x3 is a 3D affine matrix of 12 values in column-major order
+type Mat4x3 []float64
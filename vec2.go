@@ -288,6 +288,153 @@ func Vec2Equals(a, b []float64) bool {
 	return equals(a[0], b[0]) && equals(a[1], b[1])
 }
 
+// Vec2Clamp constrains the components of a vec2 to lie between the components of min and max
+func Vec2Clamp(out, a, min, max []float64) []float64 {
+	out[0] = math.Min(math.Max(a[0], min[0]), max[0])
+	out[1] = math.Min(math.Max(a[1], min[1]), max[1])
+	return out
+}
+
+// Vec2ClampScalar constrains the components of a vec2 to lie between min and max
+func Vec2ClampScalar(out, a []float64, min, max float64) []float64 {
+	out[0] = math.Min(math.Max(a[0], min), max)
+	out[1] = math.Min(math.Max(a[1], min), max)
+	return out
+}
+
+// Vec2MinScalar returns the minimum of each component of a vec2 and a scalar
+func Vec2MinScalar(out, a []float64, b float64) []float64 {
+	out[0] = math.Min(a[0], b)
+	out[1] = math.Min(a[1], b)
+	return out
+}
+
+// Vec2MaxScalar returns the maximum of each component of a vec2 and a scalar
+func Vec2MaxScalar(out, a []float64, b float64) []float64 {
+	out[0] = math.Max(a[0], b)
+	out[1] = math.Max(a[1], b)
+	return out
+}
+
+// Vec2Mix performs a linear interpolation between two vec2's with a weight for each component
+func Vec2Mix(out, a, b, t []float64) []float64 {
+	out[0] = a[0] + t[0]*(b[0]-a[0])
+	out[1] = a[1] + t[1]*(b[1]-a[1])
+	return out
+}
+
+// Vec2Step returns 0 for each component of a vec2 smaller than the edge and 1 otherwise
+func Vec2Step(out, edge, a []float64) []float64 {
+	out[0] = step(edge[0], a[0])
+	out[1] = step(edge[1], a[1])
+	return out
+}
+
+// Vec2Smoothstep performs a smooth hermite interpolation between 0 and 1 for each component of a vec2 between edge0 and edge1
+func Vec2Smoothstep(out, edge0, edge1, a []float64) []float64 {
+	out[0] = smoothstep(edge0[0], edge1[0], a[0])
+	out[1] = smoothstep(edge0[1], edge1[1], a[1])
+	return out
+}
+
+// Vec2Fract returns the fractional part of the components of a vec2
+func Vec2Fract(out, a []float64) []float64 {
+	out[0] = fract(a[0])
+	out[1] = fract(a[1])
+	return out
+}
+
+// Vec2Mod returns the GLSL modulo of the components of a vec2, which has the sign of the divisor
+func Vec2Mod(out, a, b []float64) []float64 {
+	out[0] = mod(a[0], b[0])
+	out[1] = mod(a[1], b[1])
+	return out
+}
+
+// Vec2ModScalar returns the GLSL modulo of the components of a vec2 by a scalar
+func Vec2ModScalar(out, a []float64, b float64) []float64 {
+	out[0] = mod(a[0], b)
+	out[1] = mod(a[1], b)
+	return out
+}
+
+// Vec2Sign returns -1, 0 or 1 for the sign of each component of a vec2
+func Vec2Sign(out, a []float64) []float64 {
+	out[0] = sign(a[0])
+	out[1] = sign(a[1])
+	return out
+}
+
+// Vec2Abs math.abs the components of a vec2
+func Vec2Abs(out, a []float64) []float64 {
+	out[0] = math.Abs(a[0])
+	out[1] = math.Abs(a[1])
+	return out
+}
+
+// Vec2Pow raises the components of a vec2 to the powers of the components of another
+func Vec2Pow(out, a, b []float64) []float64 {
+	out[0] = math.Pow(a[0], b[0])
+	out[1] = math.Pow(a[1], b[1])
+	return out
+}
+
+// Vec2Exp math.exp the components of a vec2
+func Vec2Exp(out, a []float64) []float64 {
+	out[0] = math.Exp(a[0])
+	out[1] = math.Exp(a[1])
+	return out
+}
+
+// Vec2Sqrt math.sqrt the components of a vec2
+func Vec2Sqrt(out, a []float64) []float64 {
+	out[0] = math.Sqrt(a[0])
+	out[1] = math.Sqrt(a[1])
+	return out
+}
+
+// Vec2InverseSqrt returns the inverse of the square root of the components of a vec2
+func Vec2InverseSqrt(out, a []float64) []float64 {
+	out[0] = 1 / math.Sqrt(a[0])
+	out[1] = 1 / math.Sqrt(a[1])
+	return out
+}
+
+// Vec2Reflect calculates the reflection direction of an incident vec2 about a unit normal
+func Vec2Reflect(out, i, n []float64) []float64 {
+	d := 2 * Vec2Dot(n, i)
+	out[0] = i[0] - d*n[0]
+	out[1] = i[1] - d*n[1]
+	return out
+}
+
+// Vec2Refract calculates the refraction direction of a unit incident vec2 through a surface with
+// a unit normal and the ratio of indices of refraction eta. It returns a zero vector on total internal reflection.
+func Vec2Refract(out, i, n []float64, eta float64) []float64 {
+	d := Vec2Dot(n, i)
+	k := 1 - eta*eta*(1-d*d)
+	if k < 0 {
+		out[0] = 0
+		out[1] = 0
+		return out
+	}
+	s := eta*d + math.Sqrt(k)
+	out[0] = eta*i[0] - s*n[0]
+	out[1] = eta*i[1] - s*n[1]
+	return out
+}
+
+// Vec2FaceForward returns a vec2 pointing in the same direction as n if dot(nref, i) < 0, otherwise its negation
+func Vec2FaceForward(out, n, i, nref []float64) []float64 {
+	s := 1.
+	if Vec2Dot(nref, i) >= 0 {
+		s = -1
+	}
+	out[0] = s * n[0]
+	out[1] = s * n[1]
+	return out
+}
+
 // Vec2Len alias for Vec2Length
 var Vec2Len = Vec2Length
 
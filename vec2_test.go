@@ -364,3 +364,73 @@ func TestVec2Zero(t *testing.T) {
 		t.Errorf("zero: %v", actual)
 	}
 }
+
+func TestVec2Clamp(t *testing.T) {
+	actual := Vec2Clamp(Vec2Create(), []float64{-1, 3}, []float64{0, 0}, []float64{1, 2})
+	if !testSlice(actual, []float64{0, 2}) {
+		t.Errorf("clamp: %v", actual)
+	}
+	actual = Vec2ClampScalar(Vec2Create(), []float64{-1, 0.5}, 0, 1)
+	if !testSlice(actual, []float64{0, 0.5}) {
+		t.Errorf("clamp scalar: %v", actual)
+	}
+}
+
+func TestVec2Mix(t *testing.T) {
+	actual := Vec2Mix(Vec2Create(), vec2A, vec2B, []float64{0.5, 1})
+	if !testSlice(actual, []float64{2, 4}) {
+		t.Errorf("mix: %v", actual)
+	}
+}
+
+func TestVec2Smoothstep(t *testing.T) {
+	actual := Vec2Smoothstep(Vec2Create(), []float64{0, 0}, []float64{2, 2}, []float64{1, 3})
+	if !testSlice(actual, []float64{0.5, 1}) {
+		t.Errorf("smoothstep: %v", actual)
+	}
+	actual = Vec2Step(Vec2Create(), []float64{1, 1}, []float64{0, 1})
+	if !testSlice(actual, []float64{0, 1}) {
+		t.Errorf("step: %v", actual)
+	}
+}
+
+func TestVec2Fract(t *testing.T) {
+	actual := Vec2Fract(Vec2Create(), []float64{1.5, -0.25})
+	if !testSlice(actual, []float64{0.5, 0.75}) {
+		t.Errorf("fract: %v", actual)
+	}
+	actual = Vec2Mod(Vec2Create(), []float64{-1, 7}, []float64{4, 4})
+	if !testSlice(actual, []float64{3, 3}) {
+		t.Errorf("mod: %v", actual)
+	}
+}
+
+func TestVec2Reflect(t *testing.T) {
+	actual := Vec2Reflect(Vec2Create(), []float64{1, -1}, []float64{0, 1})
+	if !testSlice(actual, []float64{1, 1}) {
+		t.Errorf("reflect: %v", actual)
+	}
+	actual = Vec2Refract(Vec2Create(), []float64{0, -1}, []float64{0, 1}, 0.5)
+	if !testSlice(actual, []float64{0, -1}) {
+		t.Errorf("refract: %v", actual)
+	}
+	actual = Vec2FaceForward(Vec2Create(), []float64{0, 1}, []float64{0, 1}, []float64{0, 1})
+	if !testSlice(actual, []float64{0, -1}) {
+		t.Errorf("face forward: %v", actual)
+	}
+}
+
+func TestVec2SignAbsSqrt(t *testing.T) {
+	actual := Vec2Sign(Vec2Create(), []float64{-3, 0})
+	if !testSlice(actual, []float64{-1, 0}) {
+		t.Errorf("sign: %v", actual)
+	}
+	actual = Vec2Abs(Vec2Create(), []float64{-3, 2})
+	if !testSlice(actual, []float64{3, 2}) {
+		t.Errorf("abs: %v", actual)
+	}
+	actual = Vec2InverseSqrt(Vec2Create(), Vec2Sqrt(Vec2Create(), []float64{16, 4}))
+	if !testSlice(actual, []float64{0.5, 1 / math.Sqrt(2)}) {
+		t.Errorf("sqrt: %v", actual)
+	}
+}
@@ -422,6 +422,173 @@ func Vec3Equals(a, b []float64) bool {
 	return equals(a[0], b[0]) && equals(a[1], b[1]) && equals(a[2], b[2])
 }
 
+// Vec3Clamp constrains the components of a Vec3 to lie between the components of min and max
+func Vec3Clamp(out, a, min, max []float64) []float64 {
+	out[0] = math.Min(math.Max(a[0], min[0]), max[0])
+	out[1] = math.Min(math.Max(a[1], min[1]), max[1])
+	out[2] = math.Min(math.Max(a[2], min[2]), max[2])
+	return out
+}
+
+// Vec3ClampScalar constrains the components of a Vec3 to lie between min and max
+func Vec3ClampScalar(out, a []float64, min, max float64) []float64 {
+	out[0] = math.Min(math.Max(a[0], min), max)
+	out[1] = math.Min(math.Max(a[1], min), max)
+	out[2] = math.Min(math.Max(a[2], min), max)
+	return out
+}
+
+// Vec3MinScalar returns the minimum of each component of a Vec3 and a scalar
+func Vec3MinScalar(out, a []float64, b float64) []float64 {
+	out[0] = math.Min(a[0], b)
+	out[1] = math.Min(a[1], b)
+	out[2] = math.Min(a[2], b)
+	return out
+}
+
+// Vec3MaxScalar returns the maximum of each component of a Vec3 and a scalar
+func Vec3MaxScalar(out, a []float64, b float64) []float64 {
+	out[0] = math.Max(a[0], b)
+	out[1] = math.Max(a[1], b)
+	out[2] = math.Max(a[2], b)
+	return out
+}
+
+// Vec3Mix performs a linear interpolation between two Vec3's with a weight for each component
+func Vec3Mix(out, a, b, t []float64) []float64 {
+	out[0] = a[0] + t[0]*(b[0]-a[0])
+	out[1] = a[1] + t[1]*(b[1]-a[1])
+	out[2] = a[2] + t[2]*(b[2]-a[2])
+	return out
+}
+
+// Vec3Step returns 0 for each component of a Vec3 smaller than the edge and 1 otherwise
+func Vec3Step(out, edge, a []float64) []float64 {
+	out[0] = step(edge[0], a[0])
+	out[1] = step(edge[1], a[1])
+	out[2] = step(edge[2], a[2])
+	return out
+}
+
+// Vec3Smoothstep performs a smooth hermite interpolation between 0 and 1 for each component of a Vec3 between edge0 and edge1
+func Vec3Smoothstep(out, edge0, edge1, a []float64) []float64 {
+	out[0] = smoothstep(edge0[0], edge1[0], a[0])
+	out[1] = smoothstep(edge0[1], edge1[1], a[1])
+	out[2] = smoothstep(edge0[2], edge1[2], a[2])
+	return out
+}
+
+// Vec3Fract returns the fractional part of the components of a Vec3
+func Vec3Fract(out, a []float64) []float64 {
+	out[0] = fract(a[0])
+	out[1] = fract(a[1])
+	out[2] = fract(a[2])
+	return out
+}
+
+// Vec3Mod returns the GLSL modulo of the components of a Vec3, which has the sign of the divisor
+func Vec3Mod(out, a, b []float64) []float64 {
+	out[0] = mod(a[0], b[0])
+	out[1] = mod(a[1], b[1])
+	out[2] = mod(a[2], b[2])
+	return out
+}
+
+// Vec3ModScalar returns the GLSL modulo of the components of a Vec3 by a scalar
+func Vec3ModScalar(out, a []float64, b float64) []float64 {
+	out[0] = mod(a[0], b)
+	out[1] = mod(a[1], b)
+	out[2] = mod(a[2], b)
+	return out
+}
+
+// Vec3Sign returns -1, 0 or 1 for the sign of each component of a Vec3
+func Vec3Sign(out, a []float64) []float64 {
+	out[0] = sign(a[0])
+	out[1] = sign(a[1])
+	out[2] = sign(a[2])
+	return out
+}
+
+// Vec3Abs math.abs the components of a Vec3
+func Vec3Abs(out, a []float64) []float64 {
+	out[0] = math.Abs(a[0])
+	out[1] = math.Abs(a[1])
+	out[2] = math.Abs(a[2])
+	return out
+}
+
+// Vec3Pow raises the components of a Vec3 to the powers of the components of another
+func Vec3Pow(out, a, b []float64) []float64 {
+	out[0] = math.Pow(a[0], b[0])
+	out[1] = math.Pow(a[1], b[1])
+	out[2] = math.Pow(a[2], b[2])
+	return out
+}
+
+// Vec3Exp math.exp the components of a Vec3
+func Vec3Exp(out, a []float64) []float64 {
+	out[0] = math.Exp(a[0])
+	out[1] = math.Exp(a[1])
+	out[2] = math.Exp(a[2])
+	return out
+}
+
+// Vec3Sqrt math.sqrt the components of a Vec3
+func Vec3Sqrt(out, a []float64) []float64 {
+	out[0] = math.Sqrt(a[0])
+	out[1] = math.Sqrt(a[1])
+	out[2] = math.Sqrt(a[2])
+	return out
+}
+
+// Vec3InverseSqrt returns the inverse of the square root of the components of a Vec3
+func Vec3InverseSqrt(out, a []float64) []float64 {
+	out[0] = 1 / math.Sqrt(a[0])
+	out[1] = 1 / math.Sqrt(a[1])
+	out[2] = 1 / math.Sqrt(a[2])
+	return out
+}
+
+// Vec3Reflect calculates the reflection direction of an incident Vec3 about a unit normal
+func Vec3Reflect(out, i, n []float64) []float64 {
+	d := 2 * Vec3Dot(n, i)
+	out[0] = i[0] - d*n[0]
+	out[1] = i[1] - d*n[1]
+	out[2] = i[2] - d*n[2]
+	return out
+}
+
+// Vec3Refract calculates the refraction direction of a unit incident Vec3 through a surface with
+// a unit normal and the ratio of indices of refraction eta. It returns a zero vector on total internal reflection.
+func Vec3Refract(out, i, n []float64, eta float64) []float64 {
+	d := Vec3Dot(n, i)
+	k := 1 - eta*eta*(1-d*d)
+	if k < 0 {
+		out[0] = 0
+		out[1] = 0
+		out[2] = 0
+		return out
+	}
+	s := eta*d + math.Sqrt(k)
+	out[0] = eta*i[0] - s*n[0]
+	out[1] = eta*i[1] - s*n[1]
+	out[2] = eta*i[2] - s*n[2]
+	return out
+}
+
+// Vec3FaceForward returns a Vec3 pointing in the same direction as n if dot(nref, i) < 0, otherwise its negation
+func Vec3FaceForward(out, n, i, nref []float64) []float64 {
+	s := 1.
+	if Vec3Dot(nref, i) >= 0 {
+		s = -1
+	}
+	out[0] = s * n[0]
+	out[1] = s * n[1]
+	out[2] = s * n[2]
+	return out
+}
+
 // Vec3Len alias for Vec3Length
 var Vec3Len = Vec3Length
 
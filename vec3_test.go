@@ -394,3 +394,131 @@ func TestVec3Zero(t *testing.T) {
 		t.Errorf("zero: %v", actual)
 	}
 }
+
+func TestVec3Clamp(t *testing.T) {
+	actual := Vec3Clamp(Vec3Create(), []float64{-1, 0.5, 3}, []float64{0, 0, 0}, []float64{1, 1, 2})
+	if !testSlice(actual, []float64{0, 0.5, 2}) {
+		t.Errorf("clamp: %v", actual)
+	}
+	actual = Vec3ClampScalar(Vec3Create(), []float64{-1, 0.5, 3}, 0, 1)
+	if !testSlice(actual, []float64{0, 0.5, 1}) {
+		t.Errorf("clamp scalar: %v", actual)
+	}
+}
+
+func TestVec3MinMaxScalar(t *testing.T) {
+	actual := Vec3MinScalar(Vec3Create(), vec3A, 2)
+	if !testSlice(actual, []float64{1, 2, 2}) {
+		t.Errorf("min scalar: %v", actual)
+	}
+	actual = Vec3MaxScalar(Vec3Create(), vec3A, 2)
+	if !testSlice(actual, []float64{2, 2, 3}) {
+		t.Errorf("max scalar: %v", actual)
+	}
+}
+
+func TestVec3Mix(t *testing.T) {
+	actual := Vec3Mix(Vec3Create(), vec3A, vec3B, []float64{0, 0.5, 1})
+	if !testSlice(actual, []float64{1, 3.5, 6}) {
+		t.Errorf("mix: %v", actual)
+	}
+}
+
+func TestVec3Step(t *testing.T) {
+	actual := Vec3Step(Vec3Create(), []float64{1, 1, 1}, []float64{0.5, 1, 2})
+	if !testSlice(actual, []float64{0, 1, 1}) {
+		t.Errorf("step: %v", actual)
+	}
+}
+
+func TestVec3Smoothstep(t *testing.T) {
+	actual := Vec3Smoothstep(Vec3Create(), []float64{0, 0, 0}, []float64{1, 1, 1}, []float64{-1, 0.25, 2})
+	if !testSlice(actual, []float64{0, 0.15625, 1}) {
+		t.Errorf("smoothstep: %v", actual)
+	}
+}
+
+func TestVec3Fract(t *testing.T) {
+	actual := Vec3Fract(Vec3Create(), []float64{1.25, -1.25, 3})
+	if !testSlice(actual, []float64{0.25, 0.75, 0}) {
+		t.Errorf("fract: %v", actual)
+	}
+}
+
+func TestVec3Mod(t *testing.T) {
+	actual := Vec3Mod(Vec3Create(), []float64{5, -5, 5}, []float64{3, 3, -3})
+	if !testSlice(actual, []float64{2, 1, -1}) {
+		t.Errorf("mod: %v", actual)
+	}
+	actual = Vec3ModScalar(Vec3Create(), []float64{5, -5, 7.5}, 2)
+	if !testSlice(actual, []float64{1, 1, 1.5}) {
+		t.Errorf("mod scalar: %v", actual)
+	}
+}
+
+func TestVec3SignAbs(t *testing.T) {
+	actual := Vec3Sign(Vec3Create(), []float64{-2, 0, 3})
+	if !testSlice(actual, []float64{-1, 0, 1}) {
+		t.Errorf("sign: %v", actual)
+	}
+	actual = Vec3Abs(Vec3Create(), []float64{-2, 0, 3})
+	if !testSlice(actual, []float64{2, 0, 3}) {
+		t.Errorf("abs: %v", actual)
+	}
+}
+
+func TestVec3PowExpSqrt(t *testing.T) {
+	actual := Vec3Pow(Vec3Create(), []float64{2, 9, 4}, []float64{3, 0.5, -1})
+	if !testSlice(actual, []float64{8, 3, 0.25}) {
+		t.Errorf("pow: %v", actual)
+	}
+	actual = Vec3Exp(Vec3Create(), []float64{0, 1, 2})
+	if !testSlice(actual, []float64{1, math.E, math.E * math.E}) {
+		t.Errorf("exp: %v", actual)
+	}
+	actual = Vec3Sqrt(Vec3Create(), []float64{4, 9, 16})
+	if !testSlice(actual, []float64{2, 3, 4}) {
+		t.Errorf("sqrt: %v", actual)
+	}
+	actual = Vec3InverseSqrt(Vec3Create(), []float64{4, 16, 0.25})
+	if !testSlice(actual, []float64{0.5, 0.25, 2}) {
+		t.Errorf("inverse sqrt: %v", actual)
+	}
+}
+
+func TestVec3Reflect(t *testing.T) {
+	actual := Vec3Reflect(Vec3Create(), []float64{1, -1, 0}, []float64{0, 1, 0})
+	if !testSlice(actual, []float64{1, 1, 0}) {
+		t.Errorf("reflect: %v", actual)
+	}
+}
+
+func TestVec3Refract(t *testing.T) {
+	i := Vec3Normalize(Vec3Create(), []float64{1, -1, 0})
+	n := []float64{0, 1, 0}
+	actual := Vec3Refract(Vec3Create(), i, n, 1)
+	if !testSlice(actual, i) {
+		t.Errorf("refract same medium: %v", actual)
+	}
+	// Snell's law: sin(out) = eta * sin(in)
+	actual = Vec3Refract(Vec3Create(), i, n, 1/1.5)
+	if !equals(actual[0], math.Sqrt(0.5)/1.5) || !equals(Vec3Length(actual), 1) {
+		t.Errorf("refract: %v", actual)
+	}
+	actual = Vec3Refract(Vec3Create(), i, n, 1.5)
+	if !testSlice(actual, []float64{0, 0, 0}) {
+		t.Errorf("refract total internal reflection: %v", actual)
+	}
+}
+
+func TestVec3FaceForward(t *testing.T) {
+	n := []float64{0, 1, 0}
+	actual := Vec3FaceForward(Vec3Create(), n, []float64{0, -1, 0}, n)
+	if !testSlice(actual, n) {
+		t.Errorf("face forward: %v", actual)
+	}
+	actual = Vec3FaceForward(Vec3Create(), n, []float64{0, 1, 0}, n)
+	if !testSlice(actual, []float64{0, -1, 0}) {
+		t.Errorf("face forward flipped: %v", actual)
+	}
+}
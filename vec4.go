@@ -343,6 +343,193 @@ func Vec4Equals(a, b []float64) bool {
 	return equals(a[0], b[0]) && equals(a[1], b[1]) && equals(a[2], b[2]) && equals(a[3], b[3])
 }
 
+// Vec4Clamp constrains the components of a vec4 to lie between the components of min and max
+func Vec4Clamp(out, a, min, max []float64) []float64 {
+	out[0] = math.Min(math.Max(a[0], min[0]), max[0])
+	out[1] = math.Min(math.Max(a[1], min[1]), max[1])
+	out[2] = math.Min(math.Max(a[2], min[2]), max[2])
+	out[3] = math.Min(math.Max(a[3], min[3]), max[3])
+	return out
+}
+
+// Vec4ClampScalar constrains the components of a vec4 to lie between min and max
+func Vec4ClampScalar(out, a []float64, min, max float64) []float64 {
+	out[0] = math.Min(math.Max(a[0], min), max)
+	out[1] = math.Min(math.Max(a[1], min), max)
+	out[2] = math.Min(math.Max(a[2], min), max)
+	out[3] = math.Min(math.Max(a[3], min), max)
+	return out
+}
+
+// Vec4MinScalar returns the minimum of each component of a vec4 and a scalar
+func Vec4MinScalar(out, a []float64, b float64) []float64 {
+	out[0] = math.Min(a[0], b)
+	out[1] = math.Min(a[1], b)
+	out[2] = math.Min(a[2], b)
+	out[3] = math.Min(a[3], b)
+	return out
+}
+
+// Vec4MaxScalar returns the maximum of each component of a vec4 and a scalar
+func Vec4MaxScalar(out, a []float64, b float64) []float64 {
+	out[0] = math.Max(a[0], b)
+	out[1] = math.Max(a[1], b)
+	out[2] = math.Max(a[2], b)
+	out[3] = math.Max(a[3], b)
+	return out
+}
+
+// Vec4Mix performs a linear interpolation between two vec4's with a weight for each component
+func Vec4Mix(out, a, b, t []float64) []float64 {
+	out[0] = a[0] + t[0]*(b[0]-a[0])
+	out[1] = a[1] + t[1]*(b[1]-a[1])
+	out[2] = a[2] + t[2]*(b[2]-a[2])
+	out[3] = a[3] + t[3]*(b[3]-a[3])
+	return out
+}
+
+// Vec4Step returns 0 for each component of a vec4 smaller than the edge and 1 otherwise
+func Vec4Step(out, edge, a []float64) []float64 {
+	out[0] = step(edge[0], a[0])
+	out[1] = step(edge[1], a[1])
+	out[2] = step(edge[2], a[2])
+	out[3] = step(edge[3], a[3])
+	return out
+}
+
+// Vec4Smoothstep performs a smooth hermite interpolation between 0 and 1 for each component of a vec4 between edge0 and edge1
+func Vec4Smoothstep(out, edge0, edge1, a []float64) []float64 {
+	out[0] = smoothstep(edge0[0], edge1[0], a[0])
+	out[1] = smoothstep(edge0[1], edge1[1], a[1])
+	out[2] = smoothstep(edge0[2], edge1[2], a[2])
+	out[3] = smoothstep(edge0[3], edge1[3], a[3])
+	return out
+}
+
+// Vec4Fract returns the fractional part of the components of a vec4
+func Vec4Fract(out, a []float64) []float64 {
+	out[0] = fract(a[0])
+	out[1] = fract(a[1])
+	out[2] = fract(a[2])
+	out[3] = fract(a[3])
+	return out
+}
+
+// Vec4Mod returns the GLSL modulo of the components of a vec4, which has the sign of the divisor
+func Vec4Mod(out, a, b []float64) []float64 {
+	out[0] = mod(a[0], b[0])
+	out[1] = mod(a[1], b[1])
+	out[2] = mod(a[2], b[2])
+	out[3] = mod(a[3], b[3])
+	return out
+}
+
+// Vec4ModScalar returns the GLSL modulo of the components of a vec4 by a scalar
+func Vec4ModScalar(out, a []float64, b float64) []float64 {
+	out[0] = mod(a[0], b)
+	out[1] = mod(a[1], b)
+	out[2] = mod(a[2], b)
+	out[3] = mod(a[3], b)
+	return out
+}
+
+// Vec4Sign returns -1, 0 or 1 for the sign of each component of a vec4
+func Vec4Sign(out, a []float64) []float64 {
+	out[0] = sign(a[0])
+	out[1] = sign(a[1])
+	out[2] = sign(a[2])
+	out[3] = sign(a[3])
+	return out
+}
+
+// Vec4Abs math.abs the components of a vec4
+func Vec4Abs(out, a []float64) []float64 {
+	out[0] = math.Abs(a[0])
+	out[1] = math.Abs(a[1])
+	out[2] = math.Abs(a[2])
+	out[3] = math.Abs(a[3])
+	return out
+}
+
+// Vec4Pow raises the components of a vec4 to the powers of the components of another
+func Vec4Pow(out, a, b []float64) []float64 {
+	out[0] = math.Pow(a[0], b[0])
+	out[1] = math.Pow(a[1], b[1])
+	out[2] = math.Pow(a[2], b[2])
+	out[3] = math.Pow(a[3], b[3])
+	return out
+}
+
+// Vec4Exp math.exp the components of a vec4
+func Vec4Exp(out, a []float64) []float64 {
+	out[0] = math.Exp(a[0])
+	out[1] = math.Exp(a[1])
+	out[2] = math.Exp(a[2])
+	out[3] = math.Exp(a[3])
+	return out
+}
+
+// Vec4Sqrt math.sqrt the components of a vec4
+func Vec4Sqrt(out, a []float64) []float64 {
+	out[0] = math.Sqrt(a[0])
+	out[1] = math.Sqrt(a[1])
+	out[2] = math.Sqrt(a[2])
+	out[3] = math.Sqrt(a[3])
+	return out
+}
+
+// Vec4InverseSqrt returns the inverse of the square root of the components of a vec4
+func Vec4InverseSqrt(out, a []float64) []float64 {
+	out[0] = 1 / math.Sqrt(a[0])
+	out[1] = 1 / math.Sqrt(a[1])
+	out[2] = 1 / math.Sqrt(a[2])
+	out[3] = 1 / math.Sqrt(a[3])
+	return out
+}
+
+// Vec4Reflect calculates the reflection direction of an incident vec4 about a unit normal
+func Vec4Reflect(out, i, n []float64) []float64 {
+	d := 2 * Vec4Dot(n, i)
+	out[0] = i[0] - d*n[0]
+	out[1] = i[1] - d*n[1]
+	out[2] = i[2] - d*n[2]
+	out[3] = i[3] - d*n[3]
+	return out
+}
+
+// Vec4Refract calculates the refraction direction of a unit incident vec4 through a surface with
+// a unit normal and the ratio of indices of refraction eta. It returns a zero vector on total internal reflection.
+func Vec4Refract(out, i, n []float64, eta float64) []float64 {
+	d := Vec4Dot(n, i)
+	k := 1 - eta*eta*(1-d*d)
+	if k < 0 {
+		out[0] = 0
+		out[1] = 0
+		out[2] = 0
+		out[3] = 0
+		return out
+	}
+	s := eta*d + math.Sqrt(k)
+	out[0] = eta*i[0] - s*n[0]
+	out[1] = eta*i[1] - s*n[1]
+	out[2] = eta*i[2] - s*n[2]
+	out[3] = eta*i[3] - s*n[3]
+	return out
+}
+
+// Vec4FaceForward returns a vec4 pointing in the same direction as n if dot(nref, i) < 0, otherwise its negation
+func Vec4FaceForward(out, n, i, nref []float64) []float64 {
+	s := 1.
+	if Vec4Dot(nref, i) >= 0 {
+		s = -1
+	}
+	out[0] = s * n[0]
+	out[1] = s * n[1]
+	out[2] = s * n[2]
+	out[3] = s * n[3]
+	return out
+}
+
 // Vec4Len alias for  Vec4Length
 var Vec4Len = Vec4Length
 
@@ -297,3 +297,65 @@ func TestVec4Zero(t *testing.T) {
 		t.Errorf("zero: %v", actual)
 	}
 }
+
+func TestVec4Clamp(t *testing.T) {
+	actual := Vec4ClampScalar(Vec4Create(), []float64{-1, 0.5, 3, 1}, 0, 1)
+	if !testSlice(actual, []float64{0, 0.5, 1, 1}) {
+		t.Errorf("clamp scalar: %v", actual)
+	}
+	actual = Vec4Clamp(Vec4Create(), vec4B, vec4A, []float64{6, 6, 6, 6})
+	if !testSlice(actual, []float64{5, 6, 6, 6}) {
+		t.Errorf("clamp: %v", actual)
+	}
+}
+
+func TestVec4MinMaxScalar(t *testing.T) {
+	actual := Vec4MinScalar(Vec4Create(), vec4A, 2.5)
+	if !testSlice(actual, []float64{1, 2, 2.5, 2.5}) {
+		t.Errorf("min scalar: %v", actual)
+	}
+	actual = Vec4MaxScalar(Vec4Create(), vec4A, 2.5)
+	if !testSlice(actual, []float64{2.5, 2.5, 3, 4}) {
+		t.Errorf("max scalar: %v", actual)
+	}
+}
+
+func TestVec4Mix(t *testing.T) {
+	actual := Vec4Mix(Vec4Create(), vec4A, vec4B, []float64{0, 0.25, 0.5, 1})
+	if !testSlice(actual, []float64{1, 3, 5, 8}) {
+		t.Errorf("mix: %v", actual)
+	}
+}
+
+func TestVec4ModFract(t *testing.T) {
+	actual := Vec4ModScalar(Vec4Create(), []float64{-1, 5, 2.5, 0}, 2)
+	if !testSlice(actual, []float64{1, 1, 0.5, 0}) {
+		t.Errorf("mod scalar: %v", actual)
+	}
+	actual = Vec4Fract(Vec4Create(), []float64{-0.5, 1.75, 2, 0.1})
+	if !testSlice(actual, []float64{0.5, 0.75, 0, 0.1}) {
+		t.Errorf("fract: %v", actual)
+	}
+}
+
+func TestVec4Pow(t *testing.T) {
+	actual := Vec4Pow(Vec4Create(), vec4A, []float64{2, 2, 2, 0.5})
+	if !testSlice(actual, []float64{1, 4, 9, 2}) {
+		t.Errorf("pow: %v", actual)
+	}
+	actual = Vec4Exp(Vec4Create(), []float64{0, 0, 0, 1})
+	if !testSlice(actual, []float64{1, 1, 1, math.E}) {
+		t.Errorf("exp: %v", actual)
+	}
+}
+
+func TestVec4Reflect(t *testing.T) {
+	actual := Vec4Reflect(Vec4Create(), []float64{1, -1, 0, 2}, []float64{0, 1, 0, 0})
+	if !testSlice(actual, []float64{1, 1, 0, 2}) {
+		t.Errorf("reflect: %v", actual)
+	}
+	actual = Vec4FaceForward(Vec4Create(), []float64{0, 1, 0, 0}, []float64{0, -1, 0, 0}, []float64{0, 1, 0, 0})
+	if !testSlice(actual, []float64{0, 1, 0, 0}) {
+		t.Errorf("face forward: %v", actual)
+	}
+}
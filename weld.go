@@ -0,0 +1,109 @@
+package glmatrix
+
+import (
+	"fmt"
+	"math"
+)
+
+// Weld merges the vertices of a mesh whose positions are within tolerance of
+// each other and whose normals, uvs and tangents differ by at most tolerance
+// in each component, so seams of different attributes are kept. A zero
+// tolerance merges exact duplicates. Each merged vertex keeps the attributes
+// of its first occurrence, the indices are remapped and the triangles which
+// collapse because two of their corners are merged are removed. It returns
+// an error if the buffers of the mesh are inconsistent.
+func (m *Mesh) Weld(tolerance float64) error {
+	if !(tolerance >= 0) || math.IsInf(tolerance, 1) {
+		panic(fmt.Sprintf("Invalid tolerance %v", tolerance))
+	}
+	if err := m.validate(); err != nil {
+		return err
+	}
+	n := m.VertexCount()
+	h := NewSpatialHash(m.weldCellSize(tolerance))
+	welded := &Mesh{Positions: make([]float64, 0, len(m.Positions))}
+	if m.Normals != nil {
+		welded.Normals = make([]float64, 0, len(m.Normals))
+	}
+	if m.UVs != nil {
+		welded.UVs = make([]float64, 0, len(m.UVs))
+	}
+	if m.Tangents != nil {
+		welded.Tangents = make([]float64, 0, len(m.Tangents))
+	}
+	// the vertices of the mesh kept for the points of the hash
+	var kept []int
+	remap := make([]int, n)
+	for i := 0; i < n; i++ {
+		p := m.Positions[i*3 : i*3+3]
+		remap[i] = -1
+		for _, j := range h.Within(p, tolerance) {
+			if m.attributesWithin(i, kept[j], tolerance) {
+				remap[i] = j
+				break
+			}
+		}
+		if remap[i] >= 0 {
+			continue
+		}
+		remap[i] = h.Insert(p)
+		kept = append(kept, i)
+		welded.Positions = append(welded.Positions, p...)
+		if m.Normals != nil {
+			welded.Normals = append(welded.Normals, m.Normals[i*3:i*3+3]...)
+		}
+		if m.UVs != nil {
+			welded.UVs = append(welded.UVs, m.UVs[i*2:i*2+2]...)
+		}
+		if m.Tangents != nil {
+			welded.Tangents = append(welded.Tangents, m.Tangents[i*4:i*4+4]...)
+		}
+	}
+
+	welded.Indices = make([]int, 0, len(m.Indices))
+	for i := 0; i < len(m.Indices); i += 3 {
+		a, b, c := remap[m.Indices[i]], remap[m.Indices[i+1]], remap[m.Indices[i+2]]
+		if a != b && b != c && c != a {
+			welded.Indices = append(welded.Indices, a, b, c)
+		}
+	}
+	*m = *welded
+	return nil
+}
+
+// weldCellSize returns a cell size for welding the vertices of a mesh which
+// is at least the tolerance and puts about one vertex in each cell of the
+// bounds of the mesh
+func (m *Mesh) weldCellSize(tolerance float64) float64 {
+	n := m.VertexCount()
+	if n == 0 {
+		return math.Max(tolerance, 1)
+	}
+	min := Vec3Clone(m.Positions[0:3])
+	max := Vec3Clone(m.Positions[0:3])
+	for i := 3; i < len(m.Positions); i += 3 {
+		Vec3Min(min, min, m.Positions[i:i+3])
+		Vec3Max(max, max, m.Positions[i:i+3])
+	}
+	size := Vec3Distance(min, max) / math.Cbrt(float64(n))
+	if size == 0 || math.IsInf(size, 0) || math.IsNaN(size) {
+		size = 1
+	}
+	return math.Max(tolerance, size)
+}
+
+// attributesWithin reports whether the normals, uvs and tangents of the ith
+// and jth vertices of a mesh differ by at most tolerance in each component
+func (m *Mesh) attributesWithin(i, j int, tolerance float64) bool {
+	within := func(values []float64, size int) bool {
+		for k := 0; k < size; k++ {
+			if math.Abs(values[i*size+k]-values[j*size+k]) > tolerance {
+				return false
+			}
+		}
+		return true
+	}
+	return (m.Normals == nil || within(m.Normals, 3)) &&
+		(m.UVs == nil || within(m.UVs, 2)) &&
+		(m.Tangents == nil || within(m.Tangents, 4))
+}
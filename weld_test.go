@@ -0,0 +1,79 @@
+package glmatrix
+
+import "testing"
+
+func TestMeshWeld(t *testing.T) {
+	// the triangles of the quad with their own vertices and a little noise
+	m := testQuadMesh()
+	m.ComputeFlatNormals()
+	m.Positions[9] += 1e-7
+	m.Normals[11] -= 1e-7
+	if err := m.Weld(1e-6); err != nil {
+		t.Fatalf("weld: %v", err)
+	}
+	if err := m.validate(); err != nil || m.VertexCount() != 4 {
+		t.Fatalf("weld vertices: %v %v", m.VertexCount(), err)
+	}
+	if !testIntSlice(m.Indices, []int{0, 1, 2, 0, 2, 3}) {
+		t.Errorf("weld indices: %v", m.Indices)
+	}
+	if !testSlice(m.Positions, testQuadMesh().Positions) || !testSlice(m.UVs, testQuadMesh().UVs) {
+		t.Errorf("weld positions: %v", m.Positions)
+	}
+}
+
+func TestMeshWeldSeam(t *testing.T) {
+	m := testQuadMesh()
+	m.ComputeFlatNormals()
+	m.UVs[6] = 0.5
+	if err := m.Weld(0); err != nil {
+		t.Fatalf("weld: %v", err)
+	}
+	if m.VertexCount() != 5 {
+		t.Errorf("weld seam: %v", m.VertexCount())
+	}
+	if !testIntSlice(m.Indices, []int{0, 1, 2, 3, 2, 4}) {
+		t.Errorf("weld seam indices: %v", m.Indices)
+	}
+}
+
+func TestMeshWeldCollapse(t *testing.T) {
+	m := &Mesh{
+		Positions: []float64{0, 0, 0, 1, 0, 0, 0, 1, 0, 0.001, 0, 0},
+		Indices:   []int{0, 1, 2, 0, 3, 2},
+	}
+	if err := m.Weld(0.01); err != nil {
+		t.Fatalf("weld: %v", err)
+	}
+	if m.VertexCount() != 3 || !testIntSlice(m.Indices, []int{0, 1, 2}) {
+		t.Errorf("weld collapse: %v %v", m.VertexCount(), m.Indices)
+	}
+}
+
+func TestMeshWeldSphere(t *testing.T) {
+	m := NewUVSphereMesh(1, 12, 6, nil)
+	m.Normals, m.UVs = nil, nil
+	if err := m.Weld(1e-9); err != nil {
+		t.Fatalf("weld: %v", err)
+	}
+	// the seam and the poles are merged
+	if m.VertexCount() != 12*5+2 {
+		t.Errorf("weld sphere: %v", m.VertexCount())
+	}
+	if err := m.validate(); err != nil {
+		t.Errorf("weld sphere: %v", err)
+	}
+}
+
+func TestMeshWeldInvalid(t *testing.T) {
+	m := testQuadMesh()
+	m.Indices = m.Indices[:5]
+	if err := m.Weld(0); err == nil {
+		t.Errorf("weld of an invalid mesh should fail")
+	}
+	m = testQuadMesh()
+	m.Indices[4] = 4
+	if err := m.Weld(0); err == nil {
+		t.Errorf("weld of an invalid mesh should fail")
+	}
+}